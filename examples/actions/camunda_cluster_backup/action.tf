@@ -0,0 +1,23 @@
+action "camunda_cluster_backup" "this" {
+  config {
+    cluster_id = camunda_cluster.test.id
+    timeout    = "1h"
+  }
+}
+
+# Take a backup before changing the connector secrets of the cluster.
+resource "camunda_cluster_connector_secret" "api_key" {
+  cluster_id = camunda_cluster.test.id
+  name       = "API_KEY"
+  value      = var.api_key
+
+  lifecycle {
+    action_trigger {
+      events  = [before_update]
+      actions = [action.camunda_cluster_backup.this]
+    }
+  }
+}
+
+# The backup can also be taken on demand with:
+#   terraform apply -invoke=action.camunda_cluster_backup.this
@@ -0,0 +1,19 @@
+action "camunda_cluster_wake" "this" {
+  config {
+    cluster_id = camunda_cluster.test.id
+  }
+}
+
+# Make sure the trial cluster is awake before creating clients on it.
+resource "camunda_cluster_client" "worker" {
+  cluster_id = camunda_cluster.test.id
+  name       = "worker"
+  scopes     = ["Zeebe"]
+
+  lifecycle {
+    action_trigger {
+      events  = [before_create]
+      actions = [action.camunda_cluster_wake.this]
+    }
+  }
+}
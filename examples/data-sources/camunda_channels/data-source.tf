@@ -0,0 +1,5 @@
+data "camunda_channels" "all" {}
+
+output "channels" {
+  value = [for channel in data.camunda_channels.all.channels : channel.name]
+}
@@ -0,0 +1,12 @@
+variable "camunda_cluster_id" {
+  description = "The ID of the cluster to list the backups of"
+  type        = string
+}
+
+data "camunda_cluster_backups" "this" {
+  cluster_id = var.camunda_cluster_id
+}
+
+output "completed_backups" {
+  value = [for backup in data.camunda_cluster_backups.this.backups : backup.id if backup.state == "COMPLETED"]
+}
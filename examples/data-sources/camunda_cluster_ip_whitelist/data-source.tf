@@ -0,0 +1,19 @@
+variable "camunda_cluster_id" {
+  description = "The ID of the cluster to audit"
+  type        = string
+}
+
+data "camunda_cluster_ip_whitelist" "this" {
+  cluster_id = var.camunda_cluster_id
+}
+
+output "ip_whitelist" {
+  value = data.camunda_cluster_ip_whitelist.this.ip_whitelist
+}
+
+check "cluster_not_open" {
+  assert {
+    condition     = !data.camunda_cluster_ip_whitelist.this.is_open
+    error_message = "Cluster ${var.camunda_cluster_id} accepts connections from the whole internet."
+  }
+}
@@ -0,0 +1,7 @@
+data "camunda_cluster_plan_types" "trial" {
+  name_regex = "(?i)trial"
+}
+
+output "plan_types" {
+  value = data.camunda_cluster_plan_types.trial.plan_types
+}
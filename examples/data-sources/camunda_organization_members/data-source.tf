@@ -0,0 +1,17 @@
+data "camunda_organization_members" "admins" {
+  roles = ["owner", "admin"]
+}
+
+output "admins" {
+  value = data.camunda_organization_members.admins.emails
+}
+
+# Make sure only people from our own domain administrate the organization.
+check "no_external_admins" {
+  assert {
+    condition = alltrue([
+      for email in data.camunda_organization_members.admins.emails : endswith(lower(email), "@example.org")
+    ])
+    error_message = "Some admins of the organization do not belong to example.org."
+  }
+}
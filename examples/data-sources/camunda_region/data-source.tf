@@ -5,3 +5,12 @@ data "camunda_region" "europe" {
 output "region" {
   value = data.camunda_region.europe.id
 }
+
+# Regions can also be looked up by their key.
+data "camunda_region" "us" {
+  key = "us-east1"
+}
+
+output "us_cloud_provider" {
+  value = data.camunda_region.us.cloud_provider
+}
@@ -0,0 +1,8 @@
+data "camunda_regions" "europe" {
+  name_regex     = "Europe"
+  cloud_provider = "gcp"
+}
+
+output "regions" {
+  value = { for region in data.camunda_regions.europe.regions : region.key => region.id }
+}
@@ -0,0 +1,13 @@
+ephemeral "camunda_access_token" "this" {}
+
+# Ephemeral values are only available to other ephemeral contexts, such as
+# write-only attributes: the token never ends up in the plan or the state.
+resource "vault_kv_secret_v2" "camunda" {
+  mount = "secret"
+  name  = "camunda/console"
+
+  data_json_wo = jsonencode({
+    access_token = ephemeral.camunda_access_token.this.access_token
+  })
+  data_json_wo_version = 1
+}
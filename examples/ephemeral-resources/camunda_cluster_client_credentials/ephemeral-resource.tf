@@ -0,0 +1,24 @@
+resource "camunda_cluster_client" "worker" {
+  cluster_id = camunda_cluster.test.id
+  name       = "worker"
+  scopes     = ["Zeebe"]
+}
+
+ephemeral "camunda_cluster_client_credentials" "worker" {
+  cluster_id    = camunda_cluster.test.id
+  client_id     = camunda_cluster_client.worker.zeebe_client_id
+  client_secret = camunda_cluster_client.worker.secret
+}
+
+# Hand a short-lived Zeebe token to a workload without storing it in the state.
+resource "kubernetes_secret_v1" "zeebe" {
+  metadata {
+    name = "zeebe-token"
+  }
+
+  data_wo = {
+    ZEEBE_ADDRESS      = ephemeral.camunda_cluster_client_credentials.worker.zeebe_address
+    ZEEBE_ACCESS_TOKEN = ephemeral.camunda_cluster_client_credentials.worker.access_token
+  }
+  data_wo_revision = 1
+}
@@ -0,0 +1,16 @@
+variable "allowed_ips" {
+  type    = list(string)
+  default = ["10.0.0.12/24", "192.168.1.1"]
+}
+
+resource "camunda_cluster_ip_whitelist" "test" {
+  cluster_id = camunda_cluster.test.id
+
+  dynamic "ip_whitelist" {
+    for_each = toset([for ip in var.allowed_ips : provider::camunda::cidr_normalize(ip)])
+    content {
+      ip          = ip_whitelist.value
+      description = "Allowed network ${ip_whitelist.value}"
+    }
+  }
+}
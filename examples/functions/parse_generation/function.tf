@@ -0,0 +1,12 @@
+variable "generation_name" {
+  type    = string
+  default = "Zeebe 8.6.3"
+}
+
+locals {
+  generation = provider::camunda::parse_generation(var.generation_name)
+}
+
+output "supports_rest_api" {
+  value = local.generation.major > 8 || (local.generation.major == 8 && local.generation.minor >= 5)
+}
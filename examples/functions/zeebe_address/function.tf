@@ -0,0 +1,24 @@
+data "camunda_channel" "this" {
+  name = "Stable"
+}
+
+data "camunda_cluster_plan_type" "this" {
+  name = "Trial Cluster"
+}
+
+data "camunda_region" "this" {
+  key = "europe-west1"
+}
+
+resource "camunda_cluster" "test" {
+  name = "test"
+
+  channel    = data.camunda_channel.this.id
+  generation = data.camunda_channel.this.default_generation.id
+  region     = data.camunda_region.this.id
+  plan_type  = data.camunda_cluster_plan_type.this.id
+}
+
+output "zeebe_address" {
+  value = provider::camunda::zeebe_address(camunda_cluster.test.id, data.camunda_region.this.zone)
+}
@@ -0,0 +1,5 @@
+# Discover the clusters of the organization with `terraform query`, add
+# `-generate-config-out=generated.tf` to generate their import blocks.
+list "camunda_cluster" "all" {
+  provider = camunda
+}
@@ -0,0 +1,13 @@
+# List the clients of every cluster of the organization.
+list "camunda_cluster_client" "all" {
+  provider = camunda
+}
+
+# List the clients of a single cluster.
+list "camunda_cluster_client" "production" {
+  provider = camunda
+
+  config {
+    cluster_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
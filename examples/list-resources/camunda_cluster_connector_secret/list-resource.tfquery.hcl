@@ -0,0 +1,14 @@
+# List the connector secrets of every cluster of the organization. Secret
+# values are not listed, they are read once the secrets are imported.
+list "camunda_cluster_connector_secret" "all" {
+  provider = camunda
+}
+
+# List the connector secrets of a single cluster.
+list "camunda_cluster_connector_secret" "production" {
+  provider = camunda
+
+  config {
+    cluster_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
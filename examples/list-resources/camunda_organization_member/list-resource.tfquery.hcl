@@ -0,0 +1,4 @@
+# List the members of the organization.
+list "camunda_organization_member" "all" {
+  provider = camunda
+}
@@ -5,6 +5,11 @@ variable "camunda_client_secret" {}
 provider "camunda" {
   client_id     = var.camunda_client_id
   client_secret = var.camunda_client_secret
+
+  # Labels added to every cluster.
+  default_labels = {
+    managed-by = "terraform"
+  }
 }
 
 # The channel containing the most recent version of Zeebe.
@@ -26,7 +31,7 @@ resource "camunda_cluster" "test" {
   name = "test"
 
   channel    = data.camunda_channel.alpha.id
-  generation = data.camunda_channel.alpha.default_generation_id
+  generation = data.camunda_channel.alpha.default_generation.id
   region     = data.camunda_region.trial.id
   plan_type  = data.camunda_cluster_plan_type.trial.id
 }
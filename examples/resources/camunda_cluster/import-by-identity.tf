@@ -0,0 +1,6 @@
+import {
+  to = camunda_cluster.test
+  identity = {
+    id = "<cluster_id>"
+  }
+}
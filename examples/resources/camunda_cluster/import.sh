@@ -0,0 +1,2 @@
+# Clusters can be imported using their ID.
+terraform import camunda_cluster.test <cluster_id>
@@ -26,7 +26,15 @@ resource "camunda_cluster" "test" {
   name = "test"
 
   channel    = data.camunda_channel.this.id
-  generation = data.camunda_channel.this.default_generation_id
+  generation = data.camunda_channel.this.default_generation.id
   region     = data.camunda_region.this.id
   plan_type  = data.camunda_cluster_plan_type.this.id
+
+  stage  = "dev"
+  labels = {
+    team = "payments"
+  }
+
+  # Processes are deployed right away, wait for Zeebe and Operate to be ready.
+  wait_for_components = ["zeebe", "operate"]
 }
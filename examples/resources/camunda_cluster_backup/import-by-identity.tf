@@ -0,0 +1,7 @@
+import {
+  to = camunda_cluster_backup.before_upgrade
+  identity = {
+    cluster_id = "<cluster_id>"
+    backup_id  = "<backup_id>"
+  }
+}
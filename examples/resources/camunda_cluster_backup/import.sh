@@ -0,0 +1,2 @@
+# Backups can be imported using the cluster ID and the backup ID.
+terraform import camunda_cluster_backup.before_upgrade <cluster_id>/<backup_id>
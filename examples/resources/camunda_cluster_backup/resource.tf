@@ -0,0 +1,8 @@
+resource "camunda_cluster_backup" "before_upgrade" {
+  cluster_id = camunda_cluster.test.id
+  timeout    = "1h"
+}
+
+output "backup_id" {
+  value = camunda_cluster_backup.before_upgrade.id
+}
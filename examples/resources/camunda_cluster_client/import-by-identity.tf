@@ -0,0 +1,7 @@
+import {
+  to = camunda_cluster_client.test
+  identity = {
+    cluster_id = "<cluster_id>"
+    client_id  = "<client_id>"
+  }
+}
@@ -0,0 +1,2 @@
+# Cluster clients can be imported using the cluster ID and the client ID.
+terraform import camunda_cluster_client.test <cluster_id>/<client_id>
@@ -26,7 +26,7 @@ resource "camunda_cluster" "test" {
   name = "test"
 
   channel    = data.camunda_channel.this.id
-  generation = data.camunda_channel.this.default_generation_id
+  generation = data.camunda_channel.this.default_generation.id
   region     = data.camunda_region.this.id
   plan_type  = data.camunda_cluster_plan_type.this.id
 }
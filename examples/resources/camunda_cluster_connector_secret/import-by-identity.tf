@@ -0,0 +1,7 @@
+import {
+  to = camunda_cluster_connector_secret.test
+  identity = {
+    cluster_id = "<cluster_id>"
+    name       = "<name>"
+  }
+}
@@ -0,0 +1,2 @@
+# Connector secrets can be imported using the cluster ID and the secret name.
+terraform import camunda_cluster_connector_secret.test <cluster_id>/<name>
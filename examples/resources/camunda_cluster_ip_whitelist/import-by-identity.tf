@@ -0,0 +1,6 @@
+import {
+  to = camunda_cluster_ip_whitelist.test
+  identity = {
+    cluster_id = "<cluster_id>"
+  }
+}
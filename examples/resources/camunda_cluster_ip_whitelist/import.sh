@@ -0,0 +1,2 @@
+# The IP whitelist can be imported using the ID of the cluster it belongs to.
+terraform import camunda_cluster_ip_whitelist.test <cluster_id>
@@ -0,0 +1,6 @@
+import {
+  to = camunda_organization_member.test
+  identity = {
+    email = "<email>"
+  }
+}
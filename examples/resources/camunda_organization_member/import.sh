@@ -0,0 +1,2 @@
+# Organization members can be imported using their email.
+terraform import camunda_organization_member.test <email>
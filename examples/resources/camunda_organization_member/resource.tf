@@ -2,3 +2,12 @@ resource "camunda_organization_member" "example" {
   email = "foo@example.org"
   roles = ["visitor"]
 }
+
+resource "camunda_organization_member" "operator" {
+  email = "bar@example.org"
+  roles = ["operationsengineer"]
+
+  # Block the apply until the invitation has been accepted.
+  wait_for_acceptance = true
+  acceptance_timeout  = "2h"
+}
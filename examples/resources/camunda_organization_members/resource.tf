@@ -0,0 +1,10 @@
+resource "camunda_organization_members" "all" {
+  members = {
+    "jane@example.org" = ["admin"]
+    "john@example.org" = ["developer", "operationsengineer"]
+    "ci@example.org"   = ["visitor"]
+  }
+
+  # Never remove the break-glass account, even though it is not listed above.
+  ignore_emails = ["breakglass@example.org"]
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultActionTimeout is how long actions wait for the operations they
+// trigger when their timeout is not set.
+const defaultActionTimeout = "30m"
+
+// configureAction returns the provider passed to the Configure method of
+// actions, or nil if the provider is not configured yet.
+func configureAction(req action.ConfigureRequest, resp *action.ConfigureResponse) *CamundaCloudProvider {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return nil
+	}
+
+	return provider
+}
+
+// actionTimeout returns the configured timeout of an action, or the default
+// one when not set. The value is checked by the IsDuration validator.
+func actionTimeout(timeout types.String) time.Duration {
+	value := timeout.ValueString()
+	if timeout.IsNull() {
+		value = defaultActionTimeout
+	}
+
+	duration, _ := time.ParseDuration(value)
+	return duration
+}
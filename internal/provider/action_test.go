@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testInvoke invokes a with config and returns the progress messages it sent.
+func testInvoke(t *testing.T, a action.Action, config interface{}) ([]string, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResp := action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, config); diags.HasError() {
+		t.Fatalf("unable to build config: %v", diags)
+	}
+
+	var messages []string
+	resp := action.InvokeResponse{
+		SendProgress: func(event action.InvokeProgressEvent) {
+			messages = append(messages, event.Message)
+		},
+	}
+	a.Invoke(ctx, action.InvokeRequest{Config: tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}}, &resp)
+
+	return messages, resp.Diagnostics
+}
+
+func TestActionTimeout(t *testing.T) {
+	t.Parallel()
+
+	if timeout := actionTimeout(types.StringNull()); timeout.String() != "30m0s" {
+		t.Errorf("expected the default timeout to be 30m, got %s", timeout)
+	}
+
+	if timeout := actionTimeout(types.StringValue("2h")); timeout.String() != "2h0m0s" {
+		t.Errorf("expected the timeout to be 2h, got %s", timeout)
+	}
+}
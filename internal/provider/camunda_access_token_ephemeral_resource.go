@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2"
+)
+
+var _ ephemeral.EphemeralResource = &CamundaAccessTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &CamundaAccessTokenEphemeralResource{}
+
+type camundaAccessTokenData struct {
+	AccessToken types.String `tfsdk:"access_token"`
+	TokenType   types.String `tfsdk:"token_type"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+type CamundaAccessTokenEphemeralResource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &CamundaAccessTokenEphemeralResource{}
+}
+
+func (r *CamundaAccessTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *CamundaAccessTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Issue a short-lived Console API access token with the credentials of the provider. The token is never stored in the plan or the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"access_token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access token",
+			},
+			"token_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of the token, usually `Bearer`",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the token expires, in RFC 3339 format",
+			},
+		},
+	}
+}
+
+func (r *CamundaAccessTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r *CamundaAccessTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	token, err := r.provider.credentials.Token(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get access token",
+			fmt.Sprintf("Unable to get token: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	data := newCamundaAccessTokenData(token)
+
+	diags := resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func newCamundaAccessTokenData(token *oauth2.Token) camundaAccessTokenData {
+	data := camundaAccessTokenData{
+		AccessToken: types.StringValue(token.AccessToken),
+		TokenType:   types.StringValue(token.Type()),
+		ExpiresAt:   types.StringNull(),
+	}
+
+	if !token.Expiry.IsZero() {
+		data.ExpiresAt = types.StringValue(token.Expiry.UTC().Format(time.RFC3339))
+	}
+
+	return data
+}
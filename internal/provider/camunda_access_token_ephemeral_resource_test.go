@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testOpen opens r with config, or an empty configuration if nil, and returns
+// its result.
+func testOpen(t *testing.T, r ephemeral.EphemeralResource, config interface{}) (tfsdk.EphemeralResultData, diag.Diagnostics) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	schemaResp := ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	typ := schemaResp.Schema.Type().TerraformType(ctx)
+	result := tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}
+
+	req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(typ, nil)}}
+	if config != nil {
+		if diags := result.Set(ctx, config); diags.HasError() {
+			t.Fatalf("unable to build config: %v", diags)
+		}
+		req.Config.Raw = result.Raw
+	}
+
+	resp := ephemeral.OpenResponse{Result: result}
+	r.Open(ctx, req, &resp)
+
+	return resp.Result, resp.Diagnostics
+}
+
+func TestCamundaAccessTokenEphemeralResource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	result, diags := testOpen(t, &CamundaAccessTokenEphemeralResource{provider: api.provider()}, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected open diagnostics: %v", diags)
+	}
+
+	var data camundaAccessTokenData
+	result.Get(context.Background(), &data)
+
+	if data.AccessToken.ValueString() != fakeAccessToken || data.TokenType.ValueString() != "Bearer" {
+		t.Errorf("unexpected token %v", data)
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, data.ExpiresAt.ValueString())
+	if err != nil || time.Until(expiresAt) <= 0 {
+		t.Errorf("expected the token to expire in the future, got %q", data.ExpiresAt.ValueString())
+	}
+}
+
+func TestCamundaAccessTokenEphemeralResourceInvalidCredentials(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	p := api.provider()
+	p.credentials.ClientSecret = "wrong"
+
+	if _, diags := testOpen(t, &CamundaAccessTokenEphemeralResource{provider: p}, nil); !diags.HasError() {
+		t.Errorf("expected invalid credentials to fail")
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	console "github.com/camunda-community-hub/console-customer-api-go"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -14,20 +12,13 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ datasource.DataSource = &CamundaChannelDataSource{}
 
-// type generation struct {
-// 	Id   types.String `tfsdk:"id"`
-// 	Name types.String `tfsdk:"name"`
-// }
-
 type channelDataSourceData struct {
-	Id                    types.String `tfsdk:"id"`
-	Name                  types.String `tfsdk:"name"`
-	DefaultGenerationName types.String `tfsdk:"default_generation_name"`
-	DefaultGenerationId   types.String `tfsdk:"default_generation_id"`
-	AllowedGenerations    types.List   `tfsdk:"allowed_generations"`
-
-	// https://github.com/hashicorp/terraform-plugin-framework/issues/191
-	// DefaultGeneration generation   `tfsdk:"default_generation"`
+	Id                    types.String      `tfsdk:"id"`
+	Name                  types.String      `tfsdk:"name"`
+	DefaultGeneration     *generationModel  `tfsdk:"default_generation"`
+	DefaultGenerationName types.String      `tfsdk:"default_generation_name"`
+	DefaultGenerationId   types.String      `tfsdk:"default_generation_id"`
+	AllowedGenerations    []generationModel `tfsdk:"allowed_generations"`
 }
 
 type CamundaChannelDataSource struct {
@@ -57,27 +48,24 @@ func (d *CamundaChannelDataSource) Schema(ctx context.Context, req datasource.Sc
 				Required:            true,
 			},
 
+			"default_generation": schema.SingleNestedAttribute{
+				Attributes:          generationSchemaAttributes(),
+				MarkdownDescription: "The default generation for this channel. The Console API only reports the ID and name of a generation, not the versions of its components such as Zeebe or Operate.",
+				Computed:            true,
+			},
 			"default_generation_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the default generation for this channel",
+				DeprecationMessage:  "Use `default_generation.id` instead.",
 				Computed:            true,
 			},
-
 			"default_generation_name": schema.StringAttribute{
 				MarkdownDescription: "The name of the default generation for this channel",
+				DeprecationMessage:  "Use `default_generation.name` instead.",
 				Computed:            true,
 			},
 			"allowed_generations": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							MarkdownDescription: "The ID of the generation",
-							Computed:            true,
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "The name of the generation",
-							Computed:            true,
-						},
-					},
+					Attributes: generationSchemaAttributes(),
 				},
 				MarkdownDescription: "The allowed generations for this channel",
 				Computed:            true,
@@ -106,11 +94,6 @@ func (d *CamundaChannelDataSource) Configure(ctx context.Context, req datasource
 	d.provider = provider
 }
 
-type Generation struct {
-	Id   string `tfsdk:"id"`
-	Name string `tfsdk:"name"`
-}
-
 func (d *CamundaChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data channelDataSourceData
 
@@ -121,8 +104,7 @@ func (d *CamundaChannelDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	ctx = context.WithValue(ctx, console.ContextAccessToken, d.provider.accessToken)
-	params, _, err := d.provider.client.DefaultAPI.GetParameters(ctx).Execute()
+	params, err := d.provider.parameters(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -139,23 +121,14 @@ func (d *CamundaChannelDataSource) Read(ctx context.Context, req datasource.Read
 			data.DefaultGenerationId = types.StringValue(channel.DefaultGeneration.Uuid)
 			data.DefaultGenerationName = types.StringValue(channel.DefaultGeneration.Name)
 
-			var allowedGenerations []Generation
+			defaultGeneration := newGenerationModel(channel.DefaultGeneration)
+			data.DefaultGeneration = &defaultGeneration
+
+			data.AllowedGenerations = []generationModel{}
 			for _, generation := range channel.AllowedGenerations {
-				allowedGenerations = append(allowedGenerations, Generation{
-					Id:   generation.Uuid,
-					Name: generation.Name,
-				})
+				data.AllowedGenerations = append(data.AllowedGenerations, newGenerationModel(generation))
 			}
 
-			allowedGenerationsTF, diags := types.ListValueFrom(ctx, types.ObjectType{
-				AttrTypes: map[string]attr.Type{
-					"id":   types.StringType,
-					"name": types.StringType,
-				},
-			}, allowedGenerations)
-			resp.Diagnostics.Append(diags...)
-			data.AllowedGenerations = allowedGenerationsTF
-
 			diags = resp.State.Set(ctx, &data)
 			resp.Diagnostics.Append(diags...)
 
@@ -163,8 +136,13 @@ func (d *CamundaChannelDataSource) Read(ctx context.Context, req datasource.Read
 		}
 	}
 
+	names := []string{}
+	for _, channel := range params.Channels {
+		names = append(names, channel.Name)
+	}
+
 	resp.Diagnostics.AddError(
 		"Client Error",
-		fmt.Sprintf("Camunda Cloud channel '%s' not found.", data.Name.ValueString()),
+		fmt.Sprintf("Camunda Cloud channel '%s' not found. Valid names are: %s.", data.Name.ValueString(), validNames(names)),
 	)
 }
@@ -1,24 +1,46 @@
 package provider
 
-// TODO: adjust, fix and reenable these tests
-// func TestAccExampleDataSource(t *testing.T) {
-// 	resource.Test(t, resource.TestCase{
-// 		PreCheck:                 func() { testAccPreCheck(t) },
-// 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-// 		Steps:                    []resource.TestStep{
-// 			// Read testing
-// 			// {
-// 			// 	Config: testAccExampleDataSourceConfig,
-// 			// 	Check: resource.ComposeAggregateTestCheckFunc(
-// 			// 		resource.TestCheckResourceAttr("data.scaffolding_example.test", "id", "example-id"),
-// 			// 	),
-// 			// },
-// 		},
-// 	})
-// }
+import (
+	"regexp"
+	"testing"
 
-// const testAccExampleDataSourceConfig = `
-// data "scaffolding_example" "test" {
-//   configurable_attribute = "example"
-// }
-// `
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCamundaChannelDataSource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: api.providerConfig() + `
+data "camunda_channel" "test" {
+  name = "Stable"
+}
+
+data "camunda_channels" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.camunda_channel.test", "id", fakeChannelStableID),
+					resource.TestCheckResourceAttr("data.camunda_channel.test", "default_generation.id", fakeGeneration86ID),
+					resource.TestCheckResourceAttr("data.camunda_channel.test", "default_generation.version", "8.6.3"),
+					resource.TestCheckResourceAttr("data.camunda_channel.test", "default_generation_id", fakeGeneration86ID),
+					resource.TestCheckResourceAttr("data.camunda_channel.test", "allowed_generations.#", "2"),
+					resource.TestCheckResourceAttr("data.camunda_channel.test", "allowed_generations.1.minor", "5"),
+					resource.TestCheckResourceAttr("data.camunda_channels.test", "channels.#", "2"),
+				),
+			},
+			// Invalid name_regex
+			{
+				Config: api.providerConfig() + `
+data "camunda_channels" "test" {
+  name_regex = "Stable("
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid name_regex"),
+			},
+		},
+	})
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/camunda-community-hub/terraform-provider-camunda/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CamundaChannelsDataSource{}
+
+type channelsDataSourceData struct {
+	Id        types.String   `tfsdk:"id"`
+	NameRegex types.String   `tfsdk:"name_regex"`
+	Channels  []channelModel `tfsdk:"channels"`
+}
+
+type channelModel struct {
+	Id                 types.String      `tfsdk:"id"`
+	Name               types.String      `tfsdk:"name"`
+	DefaultGeneration  generationModel   `tfsdk:"default_generation"`
+	AllowedGenerations []generationModel `tfsdk:"allowed_generations"`
+}
+
+type CamundaChannelsDataSource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaChannelsDataSource() datasource.DataSource {
+	return &CamundaChannelsDataSource{}
+}
+
+func (d *CamundaChannelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channels"
+}
+
+func (d *CamundaChannelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the channels available to the organization",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return the channels whose name matches this regular expression",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsRegex{},
+				},
+			},
+			"channels": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the channel",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the channel",
+							Computed:            true,
+						},
+						"default_generation": schema.SingleNestedAttribute{
+							Attributes:          generationSchemaAttributes(),
+							MarkdownDescription: "The default generation for this channel. The Console API only reports the ID and name of a generation, not the versions of its components such as Zeebe or Operate.",
+							Computed:            true,
+						},
+						"allowed_generations": schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: generationSchemaAttributes(),
+							},
+							MarkdownDescription: "The allowed generations for this channel",
+							Computed:            true,
+						},
+					},
+				},
+				MarkdownDescription: "The channels matching the filters",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CamundaChannelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *CamundaChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data channelsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := d.provider.parameters(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read parameters, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid name_regex",
+			fmt.Sprintf("Unable to parse the regular expression '%s', got error: %s", data.NameRegex.ValueString(), err),
+		)
+		return
+	}
+
+	data.Id = types.StringValue("channels")
+	data.Channels = []channelModel{}
+
+	for _, channel := range params.Channels {
+		if !nameRegex.MatchString(channel.Name) {
+			continue
+		}
+
+		allowedGenerations := []generationModel{}
+		for _, generation := range channel.AllowedGenerations {
+			allowedGenerations = append(allowedGenerations, newGenerationModel(generation))
+		}
+
+		data.Channels = append(data.Channels, channelModel{
+			Id:                 types.StringValue(channel.Uuid),
+			Name:               types.StringValue(channel.Name),
+			DefaultGeneration:  newGenerationModel(channel.DefaultGeneration),
+			AllowedGenerations: allowedGenerations,
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
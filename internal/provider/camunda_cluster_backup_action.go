@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/camunda-community-hub/terraform-provider-camunda/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.Action = &CamundaClusterBackupAction{}
+var _ action.ActionWithConfigure = &CamundaClusterBackupAction{}
+
+type camundaClusterBackupActionData struct {
+	ClusterId         types.String `tfsdk:"cluster_id"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout           types.String `tfsdk:"timeout"`
+}
+
+type CamundaClusterBackupAction struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaClusterBackupAction() action.Action {
+	return &CamundaClusterBackupAction{}
+}
+
+func (a *CamundaClusterBackupAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_backup"
+}
+
+func (a *CamundaClusterBackupAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Trigger a backup of a cluster.",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Cluster ID",
+				Required:            true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait until the backup is completed. Defaults to `true`.",
+				Optional:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long to wait for the backup to be completed when `wait_for_completion` is set, as a duration such as `30m` or `2h`. Defaults to `%s`.", defaultActionTimeout),
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsDuration{},
+				},
+			},
+		},
+	}
+}
+
+func (a *CamundaClusterBackupAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.provider = configureAction(req, resp)
+}
+
+func (a *CamundaClusterBackupAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data camundaClusterBackupActionData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, a.provider.accessToken)
+	clusterId := data.ClusterId.ValueString()
+
+	backup, _, err := a.provider.client.DefaultAPI.CreateBackup(ctx, clusterId).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to create backup of cluster ID=%s, got error: %s", clusterId, formatClientError(err)),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Camunda cluster backup created", map[string]interface{}{
+		"clusterID": clusterId,
+		"backupID":  backup.BackupId,
+	})
+
+	if !data.WaitForCompletion.IsNull() && !data.WaitForCompletion.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Backup %s of cluster %s started", backup.BackupId, clusterId),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for backup %s of cluster %s to be completed", backup.BackupId, clusterId),
+	})
+
+	_, err = waitForBackupCompleted(ctx, a.provider, clusterId, backup.BackupId, actionTimeout(data.Timeout))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to back up cluster",
+			fmt.Sprintf("Backup %s of cluster %s never completed; got error: %s", backup.BackupId, clusterId, err),
+		)
+		return
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCamundaClusterBackupAction(t *testing.T) {
+	for name, testCase := range map[string]struct {
+		wait   types.Bool
+		expect string
+	}{
+		"wait by default": {
+			wait:   types.BoolNull(),
+			expect: backupStateCompleted,
+		},
+		"no wait": {
+			wait:   types.BoolValue(false),
+			expect: backupStateInProgress,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			api := newFakeConsoleAPI(t)
+			api.addCluster("cluster-1")
+
+			_, diags := testInvoke(t, &CamundaClusterBackupAction{provider: api.provider()}, camundaClusterBackupActionData{
+				ClusterId:         types.StringValue("cluster-1"),
+				WaitForCompletion: testCase.wait,
+				Timeout:           types.StringNull(),
+			})
+			if diags.HasError() {
+				t.Fatalf("unexpected invoke diagnostics: %v", diags)
+			}
+
+			backups := api.backups["cluster-1"]
+			if len(backups) != 1 || backups[0].BackupState != testCase.expect {
+				t.Errorf("expected a single %s backup, got %v", testCase.expect, backups)
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/camunda-community-hub/terraform-provider-camunda/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CamundaClusterBackupResource{}
+var _ resource.ResourceWithImportState = &CamundaClusterBackupResource{}
+var _ resource.ResourceWithIdentity = &CamundaClusterBackupResource{}
+
+type camundaClusterBackupData struct {
+	Id         types.String `tfsdk:"id"`
+	ClusterId  types.String `tfsdk:"cluster_id"`
+	State      types.String `tfsdk:"state"`
+	BackupTime types.String `tfsdk:"backup_time"`
+	Timeout    types.String `tfsdk:"timeout"`
+
+	DeleteOnCreateFailure types.Bool `tfsdk:"delete_on_create_failure"`
+}
+
+type camundaClusterBackupIdentity struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+	BackupId  types.String `tfsdk:"backup_id"`
+}
+
+type CamundaClusterBackupResource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaClusterBackupResource() resource.Resource {
+	return &CamundaClusterBackupResource{}
+}
+
+func (r *CamundaClusterBackupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_backup"
+}
+
+func (r *CamundaClusterBackupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage a backup of a cluster on Camunda SaaS. The backup is taken on creation and deleted on destroy.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Backup ID",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Cluster ID",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the backup, such as `IN_PROGRESS` or `COMPLETED`",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"backup_time": schema.StringAttribute{
+				MarkdownDescription: "When the backup was taken",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long to wait, on creation, for the backup to be completed, as a duration such as `30m` or `2h`. Defaults to `%s`.", defaultActionTimeout),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(defaultActionTimeout),
+				Validators: []validator.String{
+					validators.IsDuration{},
+				},
+			},
+			"delete_on_create_failure": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete the backup when it is not completed within `timeout` on creation, instead of keeping it as tainted. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *CamundaClusterBackupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Cluster ID",
+			},
+			"backup_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Backup ID",
+			},
+		},
+	}
+}
+
+func (r *CamundaClusterBackupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r *CamundaClusterBackupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data camundaClusterBackupData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+	clusterId := data.ClusterId.ValueString()
+
+	backup, _, err := r.provider.client.DefaultAPI.CreateBackup(ctx, clusterId).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create cluster backup",
+			fmt.Sprintf("Unable to create backup of cluster ID=%s, got error: %s", clusterId, formatClientError(err)),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Camunda cluster backup created", map[string]interface{}{
+		"clusterID": clusterId,
+		"backupID":  backup.BackupId,
+	})
+
+	data.setBackup(*backup)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, data.identity())
+	resp.Diagnostics.Append(diags...)
+
+	// Taking a backup takes some time, wait until it's completed.
+	timeout, _ := time.ParseDuration(data.Timeout.ValueString())
+
+	backup, err = waitForBackupCompleted(ctx, r.provider, clusterId, data.Id.ValueString(), timeout)
+	if err != nil {
+		detail := fmt.Sprintf("Backup %s of cluster %s never completed within %s; got error: %s",
+			data.Id.ValueString(), clusterId, data.Timeout.ValueString(), err)
+
+		if data.DeleteOnCreateFailure.ValueBool() {
+			if _, err := r.provider.client.DefaultAPI.DeleteBackup(ctx, clusterId, data.Id.ValueString()).Execute(); err != nil {
+				detail += fmt.Sprintf("\n\nUnable to delete the backup, got error: %s", formatClientError(err))
+			} else {
+				detail += "\n\nThe backup was deleted."
+				resp.State.RemoveResource(ctx)
+			}
+		}
+
+		resp.Diagnostics.AddError("Unable to create cluster backup", detail)
+		return
+	}
+
+	data.setBackup(*backup)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CamundaClusterBackupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data camundaClusterBackupData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+
+	backups, response, err := r.provider.client.DefaultAPI.GetBackups(ctx, data.ClusterId.ValueString()).Execute()
+	if err != nil && response != nil && response.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read backups of cluster ID=%s, got error: %s", data.ClusterId.ValueString(), formatClientError(err)),
+		)
+		return
+	}
+
+	backup := findBackup(backups, data.Id.ValueString())
+	if backup == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setBackup(*backup)
+
+	// Imported backups have no timeout yet.
+	if data.Timeout.IsNull() {
+		data.Timeout = types.StringValue(defaultActionTimeout)
+	}
+	if data.DeleteOnCreateFailure.IsNull() {
+		data.DeleteOnCreateFailure = types.BoolValue(false)
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, data.identity())
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CamundaClusterBackupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data camundaClusterBackupData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the timeout and delete_on_create_failure can change in-place, they
+	// only apply on creation.
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CamundaClusterBackupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data camundaClusterBackupData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+
+	response, err := r.provider.client.DefaultAPI.DeleteBackup(ctx, data.ClusterId.ValueString(), data.Id.ValueString()).Execute()
+	if err != nil && response != nil && response.StatusCode == http.StatusNotFound {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to delete backup ID=%s of cluster ID=%s, got error: %s",
+				data.Id.ValueString(), data.ClusterId.ValueString(), formatClientError(err)),
+		)
+		return
+	}
+}
+
+func (r *CamundaClusterBackupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity camundaClusterBackupIdentity
+
+	if req.ID != "" {
+		parts, ok := splitImportID(req, resp, "<cluster_id>/<backup_id>")
+		if !ok {
+			return
+		}
+
+		identity.ClusterId = types.StringValue(parts[0])
+		identity.BackupId = types.StringValue(parts[1])
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), identity.ClusterId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.BackupId)...)
+}
+
+func (data *camundaClusterBackupData) setBackup(backup console.BackupDto) {
+	data.Id = types.StringValue(backup.BackupId)
+	data.State = types.StringValue(backup.BackupState)
+	data.BackupTime = types.StringPointerValue(backup.BackupTime)
+}
+
+func (data camundaClusterBackupData) identity() camundaClusterBackupIdentity {
+	return camundaClusterBackupIdentity{
+		ClusterId: data.ClusterId,
+		BackupId:  data.Id,
+	}
+}
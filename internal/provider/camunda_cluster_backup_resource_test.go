@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCamundaClusterBackupResource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: api.providerConfig() + testAccCamundaClusterBackupResourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("camunda_cluster_backup.test", "state", backupStateCompleted),
+					resource.TestCheckResourceAttr("camunda_cluster_backup.test", "timeout", "30m"),
+					resource.TestCheckResourceAttrSet("camunda_cluster_backup.test", "id"),
+					resource.TestCheckResourceAttrSet("camunda_cluster_backup.test", "backup_time"),
+					resource.TestCheckResourceAttrPair("camunda_cluster_backup.test", "cluster_id", "camunda_cluster.test", "id"),
+				),
+			},
+			// Data source testing
+			{
+				Config: api.providerConfig() + testAccCamundaClusterBackupResourceConfig() + `
+data "camunda_cluster_backups" "test" {
+  cluster_id = camunda_cluster_backup.test.cluster_id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.camunda_cluster_backups.test", "backups.#", "1"),
+					resource.TestCheckResourceAttrPair("data.camunda_cluster_backups.test", "backups.0.id", "camunda_cluster_backup.test", "id"),
+					resource.TestCheckResourceAttr("data.camunda_cluster_backups.test", "backups.0.state", backupStateCompleted),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "camunda_cluster_backup.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccClusterNestedImportID("camunda_cluster_backup.test", "id"),
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCamundaClusterBackupResourceConfig() string {
+	return testAccClusterConfig("tf-acc-cluster") + `
+resource "camunda_cluster_backup" "test" {
+  cluster_id = camunda_cluster.test.id
+}
+`
+}
+
+func TestCamundaClusterBackupResourceDeleteOnCreateFailure(t *testing.T) {
+	for name, deleteOnCreateFailure := range map[string]bool{"kept": false, "deleted": true} {
+		t.Run(name, func(t *testing.T) {
+			api := newFakeConsoleAPI(t)
+			api.addCluster("cluster-1")
+			api.creatingPolls = math.MaxInt
+			r := &CamundaClusterBackupResource{provider: api.provider()}
+
+			state, diags := testCreate(t, r, camundaClusterBackupData{
+				Id:                    types.StringUnknown(),
+				ClusterId:             types.StringValue("cluster-1"),
+				State:                 types.StringUnknown(),
+				BackupTime:            types.StringUnknown(),
+				Timeout:               types.StringValue("1s"),
+				DeleteOnCreateFailure: types.BoolValue(deleteOnCreateFailure),
+			})
+			if !diags.HasError() {
+				t.Fatalf("expected the creation of a stalled backup to fail")
+			}
+
+			expect := 1
+			if deleteOnCreateFailure {
+				expect = 0
+			}
+
+			if count := len(api.backups["cluster-1"]); count != expect {
+				t.Errorf("expected %d backups left, got %d", expect, count)
+			}
+			if state.Raw.IsNull() != deleteOnCreateFailure {
+				t.Errorf("expected the backup to be removed from the state only when deleted")
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CamundaClusterBackupsDataSource{}
+
+type clusterBackupsDataSourceData struct {
+	Id        types.String  `tfsdk:"id"`
+	ClusterId types.String  `tfsdk:"cluster_id"`
+	Backups   []backupModel `tfsdk:"backups"`
+}
+
+type backupModel struct {
+	Id         types.String `tfsdk:"id"`
+	State      types.String `tfsdk:"state"`
+	BackupTime types.String `tfsdk:"backup_time"`
+}
+
+type CamundaClusterBackupsDataSource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaClusterBackupsDataSource() datasource.DataSource {
+	return &CamundaClusterBackupsDataSource{}
+}
+
+func (d *CamundaClusterBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_backups"
+}
+
+func (d *CamundaClusterBackupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the backups of a cluster",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID",
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Cluster ID",
+				Required:            true,
+			},
+			"backups": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the backup",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "The state of the backup, such as `IN_PROGRESS` or `COMPLETED`",
+							Computed:            true,
+						},
+						"backup_time": schema.StringAttribute{
+							MarkdownDescription: "When the backup was taken",
+							Computed:            true,
+						},
+					},
+				},
+				MarkdownDescription: "The backups of the cluster",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CamundaClusterBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *CamundaClusterBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data clusterBackupsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := data.ClusterId.ValueString()
+	ctx = context.WithValue(ctx, console.ContextAccessToken, d.provider.accessToken)
+
+	backups, _, err := d.provider.client.DefaultAPI.GetBackups(ctx, clusterId).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read backups of cluster ID=%s, got error: %s", clusterId, formatClientError(err)),
+		)
+		return
+	}
+
+	data.Id = types.StringValue(clusterId)
+	data.Backups = []backupModel{}
+	for _, backup := range backups {
+		data.Backups = append(data.Backups, backupModel{
+			Id:         types.StringValue(backup.BackupId),
+			State:      types.StringValue(backup.BackupState),
+			BackupTime: types.StringPointerValue(backup.BackupTime),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
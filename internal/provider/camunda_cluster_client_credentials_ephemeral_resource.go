@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+var _ ephemeral.EphemeralResource = &CamundaClusterClientCredentialsEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &CamundaClusterClientCredentialsEphemeralResource{}
+
+// zeebeTokenAudience is the audience of the tokens of cluster clients.
+const zeebeTokenAudience = "zeebe.camunda.io"
+
+type camundaClusterClientCredentialsData struct {
+	ClusterId    types.String `tfsdk:"cluster_id"`
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	Audience     types.String `tfsdk:"audience"`
+
+	AccessToken            types.String `tfsdk:"access_token"`
+	TokenType              types.String `tfsdk:"token_type"`
+	ExpiresAt              types.String `tfsdk:"expires_at"`
+	ZeebeAddress           types.String `tfsdk:"zeebe_address"`
+	AuthorizationServerUrl types.String `tfsdk:"authorization_server_url"`
+}
+
+type CamundaClusterClientCredentialsEphemeralResource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaClusterClientCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &CamundaClusterClientCredentialsEphemeralResource{}
+}
+
+func (r *CamundaClusterClientCredentialsEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_client_credentials"
+}
+
+func (r *CamundaClusterClientCredentialsEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Issue an OAuth access token for a cluster client, from the authorization server of the cluster. The token is never stored in the plan or the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Cluster ID",
+			},
+			"client_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The client ID, such as the `zeebe_client_id` of a `camunda_cluster_client`",
+			},
+			"client_secret": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The client secret, such as the `secret` of a `camunda_cluster_client`",
+			},
+			"audience": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The audience of the token. Defaults to `%s`.", zeebeTokenAudience),
+			},
+			"access_token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The access token",
+			},
+			"token_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The type of the token, usually `Bearer`",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the token expires, in RFC 3339 format",
+			},
+			"zeebe_address": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Zeebe Address",
+			},
+			"authorization_server_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL the token was issued by",
+			},
+		},
+	}
+}
+
+func (r *CamundaClusterClientCredentialsEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r *CamundaClusterClientCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data camundaClusterClientCredentialsData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	audience := zeebeTokenAudience
+	if !data.Audience.IsNull() {
+		audience = data.Audience.ValueString()
+	}
+
+	apiCtx := context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+
+	client, _, err := r.provider.client.DefaultAPI.
+		GetClient(apiCtx, data.ClusterId.ValueString(), data.ClientId.ValueString()).
+		Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read cluster client ID=%s, got error: %s", data.ClientId.ValueString(), formatClientError(err)),
+		)
+		return
+	}
+
+	config := clientcredentials.Config{
+		ClientID:     data.ClientId.ValueString(),
+		ClientSecret: data.ClientSecret.ValueString(),
+		TokenURL:     client.ZEEBE_AUTHORIZATION_SERVER_URL,
+		EndpointParams: map[string][]string{
+			"audience": {audience},
+		},
+	}
+
+	token, err := config.Token(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get cluster client access token",
+			fmt.Sprintf("Unable to get token from %s: %s", client.ZEEBE_AUTHORIZATION_SERVER_URL, formatClientError(err)),
+		)
+		return
+	}
+
+	tokenData := newCamundaAccessTokenData(token)
+	data.AccessToken = tokenData.AccessToken
+	data.TokenType = tokenData.TokenType
+	data.ExpiresAt = tokenData.ExpiresAt
+	data.ZeebeAddress = types.StringValue(client.ZEEBE_ADDRESS)
+	data.AuthorizationServerUrl = types.StringValue(client.ZEEBE_AUTHORIZATION_SERVER_URL)
+
+	diags = resp.Result.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
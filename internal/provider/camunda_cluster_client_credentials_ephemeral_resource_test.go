@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCamundaClusterClientCredentialsEphemeralResource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+	api.addCluster("cluster-1")
+	api.addClient("cluster-1", "worker")
+
+	var clientID, clientSecret string
+	for id, client := range api.clients["cluster-1"] {
+		clientID, clientSecret = id, client.ClientSecret
+	}
+
+	r := &CamundaClusterClientCredentialsEphemeralResource{provider: api.provider()}
+
+	config := camundaClusterClientCredentialsData{
+		ClusterId:    types.StringValue("cluster-1"),
+		ClientId:     types.StringValue(clientID),
+		ClientSecret: types.StringValue(clientSecret),
+		Audience:     types.StringNull(),
+	}
+
+	result, diags := testOpen(t, r, config)
+	if diags.HasError() {
+		t.Fatalf("unexpected open diagnostics: %v", diags)
+	}
+
+	var data camundaClusterClientCredentialsData
+	result.Get(context.Background(), &data)
+
+	if data.AccessToken.ValueString() != "zeebe-token-"+clientID {
+		t.Errorf("expected a Zeebe token for the client, got %q", data.AccessToken.ValueString())
+	}
+
+	if !strings.HasPrefix(data.AuthorizationServerUrl.ValueString(), api.server.URL) || data.ZeebeAddress.ValueString() == "" {
+		t.Errorf("unexpected connection details %v", data)
+	}
+
+	config.ClientSecret = types.StringValue("wrong")
+	if _, diags := testOpen(t, r, config); !diags.HasError() {
+		t.Errorf("expected an invalid client secret to fail")
+	}
+
+	config.ClientId = types.StringValue("unknown")
+	if _, diags := testOpen(t, r, config); !diags.HasError() {
+		t.Errorf("expected an unknown client to fail")
+	}
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &CamundaClusterClientListResource{}
+var _ list.ListResourceWithConfigure = &CamundaClusterClientListResource{}
+
+type camundaClusterClientListConfig struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+}
+
+// listedClusterClient is a client along with the cluster it belongs to.
+type listedClusterClient struct {
+	clusterId string
+	client    console.ClusterClient
+}
+
+type CamundaClusterClientListResource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaClusterClientListResource() list.ListResource {
+	return &CamundaClusterClientListResource{}
+}
+
+func (r *CamundaClusterClientListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_client"
+}
+
+func (r *CamundaClusterClientListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the clients of the clusters of the organization",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the clients of this cluster. Defaults to the clients of every cluster.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *CamundaClusterClientListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider = configureListResource(req, resp)
+}
+
+func (r *CamundaClusterClientListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config camundaClusterClientListConfig
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+
+	clusterIds, err := listClusterIds(ctx, r.provider, config.ClusterId)
+	if err != nil {
+		stream.Results = listError("Client Error", fmt.Sprintf("Unable to list clusters, got error: %s", formatClientError(err)))
+		return
+	}
+
+	var clients []listedClusterClient
+	for _, clusterId := range clusterIds {
+		clusterClients, _, err := r.provider.client.DefaultAPI.GetClients(ctx, clusterId).Execute()
+		if err != nil {
+			stream.Results = listError("Client Error",
+				fmt.Sprintf("Unable to list the clients of cluster ID=%s, got error: %s", clusterId, formatClientError(err)))
+			return
+		}
+
+		for _, client := range clusterClients {
+			clients = append(clients, listedClusterClient{clusterId: clusterId, client: client})
+		}
+	}
+
+	stream.Results = listResults(ctx, req, clients, func(item listedClusterClient, result *list.ListResult) {
+		result.DisplayName = item.client.Name
+
+		data := camundaClusterClientData{
+			// The client UUID is only returned on creation, the client ID identifies it as well.
+			Id:            types.StringValue(item.client.ClientId),
+			ClusterId:     types.StringValue(item.clusterId),
+			Name:          types.StringValue(item.client.Name),
+			Secret:        types.StringNull(),
+			ZeebeClientId: types.StringValue(item.client.ClientId),
+		}
+
+		result.Diagnostics.Append(result.Identity.Set(ctx, data.identity())...)
+
+		if !req.IncludeResource {
+			return
+		}
+
+		details, _, err := r.provider.client.DefaultAPI.GetClient(ctx, item.clusterId, item.client.ClientId).Execute()
+		if err != nil {
+			result.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to read cluster client ID=%s, got error: %s", item.client.ClientId, formatClientError(err)),
+			)
+			return
+		}
+
+		data.Scopes = []types.String{}
+		for _, permission := range details.Permissions {
+			data.Scopes = append(data.Scopes, types.StringValue(permission))
+		}
+		data.ZeebeAddress = types.StringValue(details.ZEEBE_ADDRESS)
+		data.ZeebeAuthorizationServerUrl = types.StringValue(details.ZEEBE_AUTHORIZATION_SERVER_URL)
+
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
+
+// listClusterIds returns clusterId if set, or the IDs of every cluster of the
+// organization.
+func listClusterIds(ctx context.Context, provider *CamundaCloudProvider, clusterId types.String) ([]string, error) {
+	if !clusterId.IsNull() {
+		return []string{clusterId.ValueString()}, nil
+	}
+
+	clusters, _, err := provider.client.DefaultAPI.GetClusters(ctx).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	var clusterIds []string
+	for _, cluster := range clusters {
+		clusterIds = append(clusterIds, cluster.Uuid)
+	}
+
+	return clusterIds, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
@@ -24,6 +25,8 @@ import (
 
 var _ resource.Resource = &CamundaClusterClientResource{}
 var _ resource.ResourceWithImportState = &CamundaClusterClientResource{}
+var _ resource.ResourceWithIdentity = &CamundaClusterClientResource{}
+var _ resource.ResourceWithUpgradeState = &CamundaClusterClientResource{}
 
 var validScopes = []string{"Operate", "Optimize", "Tasklist", "Zeebe"}
 
@@ -39,6 +42,11 @@ type camundaClusterClientData struct {
 	ZeebeAuthorizationServerUrl types.String `tfsdk:"zeebe_authorization_server_url"`
 }
 
+type camundaClusterClientIdentity struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+	ClientId  types.String `tfsdk:"client_id"`
+}
+
 type CamundaClusterClientResource struct {
 	provider *CamundaCloudProvider
 }
@@ -59,6 +67,7 @@ func (r *CamundaClusterClientResource) Schema(ctx context.Context, req resource.
 
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manage a cluster client on Camunda SaaS.",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -132,6 +141,21 @@ func (r *CamundaClusterClientResource) Schema(ctx context.Context, req resource.
 	}
 }
 
+func (r *CamundaClusterClientResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Cluster ID",
+			},
+			"client_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Client ID used to authenticate against Zeebe",
+			},
+		},
+	}
+}
+
 func (r *CamundaClusterClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Provider not yet configured
 	if req.ProviderData == nil {
@@ -220,6 +244,9 @@ func (r *CamundaClusterClientResource) Create(ctx context.Context, req resource.
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, data.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CamundaClusterClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -254,10 +281,17 @@ func (r *CamundaClusterClientResource) Read(ctx context.Context, req resource.Re
 	data.ZeebeClientId = types.StringValue(client.ZEEBE_CLIENT_ID)
 	data.ZeebeAddress = types.StringValue(client.ZEEBE_ADDRESS)
 	data.ZeebeAuthorizationServerUrl = types.StringValue(client.ZEEBE_AUTHORIZATION_SERVER_URL)
-	// TODO: implement reading scopes while reading from the API
+
+	data.Scopes = []types.String{}
+	for _, permission := range client.Permissions {
+		data.Scopes = append(data.Scopes, types.StringValue(permission))
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, data.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CamundaClusterClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -299,5 +333,77 @@ func (r *CamundaClusterClientResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *CamundaClusterClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var identity camundaClusterClientIdentity
+
+	if req.ID != "" {
+		parts, ok := splitImportID(req, resp, "<cluster_id>/<client_id>")
+		if !ok {
+			return
+		}
+
+		identity.ClusterId = types.StringValue(parts[0])
+		identity.ClientId = types.StringValue(parts[1])
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The client UUID is only returned on creation, the client ID identifies it as well.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ClientId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), identity.ClusterId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zeebe_client_id"), identity.ClientId)...)
+}
+
+func (data camundaClusterClientData) identity() camundaClusterClientIdentity {
+	return camundaClusterClientIdentity{
+		ClusterId: data.ClusterId,
+		ClientId:  data.ZeebeClientId,
+	}
+}
+
+func (r *CamundaClusterClientResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: stateUpgrader(camundaClusterClientSchemaV0, upgradeCamundaClusterClientStateV0),
+	}
+}
+
+// camundaClusterClientDataV0 is the state of cluster clients before schemas
+// were versioned.
+type camundaClusterClientDataV0 struct {
+	Id                          types.String   `tfsdk:"id"`
+	ClusterId                   types.String   `tfsdk:"cluster_id"`
+	Name                        types.String   `tfsdk:"name"`
+	Secret                      types.String   `tfsdk:"secret"`
+	Scopes                      []types.String `tfsdk:"scopes"`
+	ZeebeAddress                types.String   `tfsdk:"zeebe_address"`
+	ZeebeClientId               types.String   `tfsdk:"zeebe_client_id"`
+	ZeebeAuthorizationServerUrl types.String   `tfsdk:"zeebe_authorization_server_url"`
+}
+
+var camundaClusterClientSchemaV0 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id":                             schema.StringAttribute{Computed: true},
+		"cluster_id":                     schema.StringAttribute{Required: true},
+		"name":                           schema.StringAttribute{Required: true},
+		"scopes":                         schema.SetAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+		"secret":                         schema.StringAttribute{Computed: true, Sensitive: true},
+		"zeebe_address":                  schema.StringAttribute{Computed: true},
+		"zeebe_client_id":                schema.StringAttribute{Computed: true},
+		"zeebe_authorization_server_url": schema.StringAttribute{Computed: true},
+	},
+}
+
+func upgradeCamundaClusterClientStateV0(prior camundaClusterClientDataV0) camundaClusterClientData {
+	return camundaClusterClientData{
+		Id:                          prior.Id,
+		ClusterId:                   prior.ClusterId,
+		Name:                        prior.Name,
+		Secret:                      prior.Secret,
+		Scopes:                      prior.Scopes,
+		ZeebeAddress:                prior.ZeebeAddress,
+		ZeebeClientId:               prior.ZeebeClientId,
+		ZeebeAuthorizationServerUrl: prior.ZeebeAuthorizationServerUrl,
+	}
 }
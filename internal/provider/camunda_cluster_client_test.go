@@ -1,46 +1,110 @@
 package provider
 
-// TODO: adjust, fix and reenable these tests
-// func TestAccCamundaClusterClientResource(t *testing.T) {
-// 	resource.Test(t, resource.TestCase{
-// 		PreCheck:                 func() { testAccPreCheck(t) },
-// 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-// 		Steps: []resource.TestStep{
-// 			// Create and Read testing
-// 			{
-// 				Config: testAccCamundaClusterClientResourceConfig("one"),
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-// 					resource.TestCheckResourceAttr("scaffolding_example.test", "configurable_attribute", "one"),
-// 					resource.TestCheckResourceAttr("scaffolding_example.test", "id", "example-id"),
-// 				),
-// 			},
-// 			// ImportState testing
-// 			{
-// 				ResourceName:      "scaffolding_example.test",
-// 				ImportState:       true,
-// 				ImportStateVerify: true,
-// 				// This is not normally necessary, but is here because this
-// 				// example code does not have an actual upstream service.
-// 				// Once the Read method is able to refresh information from
-// 				// the upstream service, this can be removed.
-// 				ImportStateVerifyIgnore: []string{"configurable_attribute"},
-// 			},
-// 			// Update and Read testing
-// 			{
-// 				Config: testAccCamundaClusterClientResourceConfig("two"),
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-// 					resource.TestCheckResourceAttr("scaffolding_example.test", "configurable_attribute", "two"),
-// 				),
-// 			},
-// 			// Delete testing automatically occurs in TestCase
-// 		},
-// 	})
-// }
-
-// func testAccCamundaClusterClientResourceConfig(configurableAttribute string) string {
-// 	return fmt.Sprintf(`
-// resource "scaffolding_example" "test" {
-//   configurable_attribute = %[1]q
-// }
-// `, configurableAttribute)
-// }
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCamundaClusterClientResource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: api.providerConfig() + testAccCamundaClusterClientResourceConfig(`"Zeebe"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("camunda_cluster_client.test", "name", "tf-acc-client"),
+					resource.TestCheckResourceAttrPair("camunda_cluster_client.test", "cluster_id", "camunda_cluster.test", "id"),
+					resource.TestCheckResourceAttr("camunda_cluster_client.test", "scopes.#", "1"),
+					resource.TestCheckResourceAttrSet("camunda_cluster_client.test", "secret"),
+					resource.TestCheckResourceAttrSet("camunda_cluster_client.test", "zeebe_client_id"),
+					resource.TestCheckResourceAttrSet("camunda_cluster_client.test", "zeebe_address"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "camunda_cluster_client.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccClusterNestedImportID("camunda_cluster_client.test", "zeebe_client_id"),
+				ImportStateVerify: true,
+				// The secret is only returned on creation, and imported clients
+				// are identified by their client ID instead of their UUID.
+				ImportStateVerifyIgnore: []string{"secret", "id"},
+			},
+			// Update and Read testing, changing the scopes replaces the client
+			{
+				Config: api.providerConfig() + testAccCamundaClusterClientResourceConfig(`"Zeebe", "Operate"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("camunda_cluster_client.test", "scopes.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCamundaClusterClientResourceConfig(scopes string) string {
+	return testAccClusterConfig("tf-acc-cluster") + fmt.Sprintf(`
+resource "camunda_cluster_client" "test" {
+  name       = "tf-acc-client"
+  cluster_id = camunda_cluster.test.id
+  scopes     = [%s]
+}
+`, scopes)
+}
+
+// testAccClusterNestedImportID returns the `<cluster_id>/<attribute>` import
+// ID of a resource nested in a cluster.
+func testAccClusterNestedImportID(name string, attribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", name)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.Attributes[attribute]), nil
+	}
+}
+
+func TestCamundaClusterClientResourceReadScopes(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	api.addCluster("cluster-1")
+	clientId := api.addClient("cluster-1", "worker")
+
+	r := &CamundaClusterClientResource{provider: api.provider()}
+
+	// An imported client only has its IDs set.
+	state := stateFrom(t, testResourceSchema(t, r), camundaClusterClientData{
+		Id:            types.StringValue(clientId),
+		ClusterId:     types.StringValue("cluster-1"),
+		ZeebeClientId: types.StringValue(clientId),
+	})
+
+	state, diags := testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	var data camundaClusterClientData
+	state.Get(ctx, &data)
+
+	var scopes []string
+	for _, scope := range data.Scopes {
+		scopes = append(scopes, scope.ValueString())
+	}
+	sort.Strings(scopes)
+
+	if !reflect.DeepEqual(scopes, validScopes) {
+		t.Errorf("expected the scopes of the client to be read, got %v", scopes)
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &CamundaClusterConnectorSecretListResource{}
+var _ list.ListResourceWithConfigure = &CamundaClusterConnectorSecretListResource{}
+
+type camundaClusterConnectorSecretListConfig struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+}
+
+type CamundaClusterConnectorSecretListResource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaClusterConnectorSecretListResource() list.ListResource {
+	return &CamundaClusterConnectorSecretListResource{}
+}
+
+func (r *CamundaClusterConnectorSecretListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_connector_secret"
+}
+
+func (r *CamundaClusterConnectorSecretListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the connector secrets of the clusters of the organization. Secret values are not listed.",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the connector secrets of this cluster. Defaults to the connector secrets of every cluster.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *CamundaClusterConnectorSecretListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider = configureListResource(req, resp)
+}
+
+func (r *CamundaClusterConnectorSecretListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config camundaClusterConnectorSecretListConfig
+
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+
+	clusterIds, err := listClusterIds(ctx, r.provider, config.ClusterId)
+	if err != nil {
+		stream.Results = listError("Client Error", fmt.Sprintf("Unable to list clusters, got error: %s", formatClientError(err)))
+		return
+	}
+
+	var secrets []camundaClusterConnectorSecret
+	for _, clusterId := range clusterIds {
+		clusterSecrets, _, err := r.provider.client.DefaultAPI.GetSecrets(ctx, clusterId).Execute()
+		if err != nil {
+			stream.Results = listError("Connector Secret Error",
+				fmt.Sprintf("Unable to list the connector secrets of cluster ID=%s, got error: %s", clusterId, formatClientError(err)))
+			return
+		}
+
+		for _, name := range sortedKeys(clusterSecrets) {
+			// Secret values are only read once the secret is imported, so
+			// that they don't end up in generated configuration.
+			secrets = append(secrets, camundaClusterConnectorSecret{
+				ClusterId: types.StringValue(clusterId),
+				Name:      types.StringValue(name),
+				Value:     types.StringNull(),
+			})
+		}
+	}
+
+	stream.Results = listResults(ctx, req, secrets, func(secret camundaClusterConnectorSecret, result *list.ListResult) {
+		result.DisplayName = secret.Name.ValueString()
+		result.Diagnostics.Append(result.Identity.Set(ctx, secret.identity())...)
+
+		if req.IncludeResource {
+			result.Diagnostics.Append(result.Resource.Set(ctx, &secret)...)
+		}
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -20,6 +21,8 @@ import (
 
 var _ resource.Resource = &CamundaClusterConnectorSecretResource{}
 var _ resource.ResourceWithImportState = &CamundaClusterConnectorSecretResource{}
+var _ resource.ResourceWithIdentity = &CamundaClusterConnectorSecretResource{}
+var _ resource.ResourceWithUpgradeState = &CamundaClusterConnectorSecretResource{}
 
 type camundaClusterConnectorSecret struct {
 	ClusterId types.String `tfsdk:"cluster_id"`
@@ -27,6 +30,11 @@ type camundaClusterConnectorSecret struct {
 	Value     types.String `tfsdk:"value"`
 }
 
+type camundaClusterConnectorSecretIdentity struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+	Name      types.String `tfsdk:"name"`
+}
+
 type CamundaClusterConnectorSecretResource struct {
 	provider *CamundaCloudProvider
 }
@@ -42,6 +50,7 @@ func (r *CamundaClusterConnectorSecretResource) Metadata(ctx context.Context, re
 func (r *CamundaClusterConnectorSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manage a cluster connector secret on Camunda SaaS.",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -73,6 +82,21 @@ func (r *CamundaClusterConnectorSecretResource) Schema(ctx context.Context, req
 	}
 }
 
+func (r *CamundaClusterConnectorSecretResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Cluster ID",
+			},
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the connector secret",
+			},
+		},
+	}
+}
+
 func (r *CamundaClusterConnectorSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Provider not yet configured
 	if req.ProviderData == nil {
@@ -138,6 +162,9 @@ func (r *CamundaClusterConnectorSecretResource) Create(ctx context.Context, req
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, data.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CamundaClusterConnectorSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -186,6 +213,9 @@ func (r *CamundaClusterConnectorSecretResource) Read(ctx context.Context, req re
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, data.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CamundaClusterConnectorSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -226,5 +256,60 @@ func (r *CamundaClusterConnectorSecretResource) Delete(ctx context.Context, req
 }
 
 func (r *CamundaClusterConnectorSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	var identity camundaClusterConnectorSecretIdentity
+
+	if req.ID != "" {
+		parts, ok := splitImportID(req, resp, "<cluster_id>/<name>")
+		if !ok {
+			return
+		}
+
+		identity.ClusterId = types.StringValue(parts[0])
+		identity.Name = types.StringValue(parts[1])
+	} else {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), identity.ClusterId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), identity.Name)...)
+}
+
+func (data camundaClusterConnectorSecret) identity() camundaClusterConnectorSecretIdentity {
+	return camundaClusterConnectorSecretIdentity{
+		ClusterId: data.ClusterId,
+		Name:      data.Name,
+	}
+}
+
+func (r *CamundaClusterConnectorSecretResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: stateUpgrader(camundaClusterConnectorSecretSchemaV0, upgradeCamundaClusterConnectorSecretStateV0),
+	}
+}
+
+// camundaClusterConnectorSecretV0 is the state of connector secrets before
+// schemas were versioned.
+type camundaClusterConnectorSecretV0 struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+	Name      types.String `tfsdk:"name"`
+	Value     types.String `tfsdk:"value"`
+}
+
+var camundaClusterConnectorSecretSchemaV0 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"cluster_id": schema.StringAttribute{Required: true},
+		"name":       schema.StringAttribute{Required: true},
+		"value":      schema.StringAttribute{Required: true, Sensitive: true},
+	},
+}
+
+func upgradeCamundaClusterConnectorSecretStateV0(prior camundaClusterConnectorSecretV0) camundaClusterConnectorSecret {
+	return camundaClusterConnectorSecret{
+		ClusterId: prior.ClusterId,
+		Name:      prior.Name,
+		Value:     prior.Value,
+	}
 }
@@ -1 +1,52 @@
 package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCamundaClusterConnectorSecretResource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: api.providerConfig() + testAccCamundaClusterConnectorSecretResourceConfig("one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("camunda_cluster_connector_secret.test", "name", "TF_ACC_SECRET"),
+					resource.TestCheckResourceAttr("camunda_cluster_connector_secret.test", "value", "one"),
+					resource.TestCheckResourceAttrPair("camunda_cluster_connector_secret.test", "cluster_id", "camunda_cluster.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "camunda_cluster_connector_secret.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccClusterNestedImportID("camunda_cluster_connector_secret.test", "name"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: api.providerConfig() + testAccCamundaClusterConnectorSecretResourceConfig("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("camunda_cluster_connector_secret.test", "value", "two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCamundaClusterConnectorSecretResourceConfig(value string) string {
+	return testAccClusterConfig("tf-acc-cluster") + fmt.Sprintf(`
+resource "camunda_cluster_connector_secret" "test" {
+  cluster_id = camunda_cluster.test.id
+  name       = "TF_ACC_SECRET"
+  value      = %q
+}
+`, value)
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CamundaClusterIPWhitelistDataSource{}
+
+type clusterIPWhitelistDataSourceData struct {
+	Id          types.String       `tfsdk:"id"`
+	ClusterID   types.String       `tfsdk:"cluster_id"`
+	IPWhitelist []ipWhitelistModel `tfsdk:"ip_whitelist"`
+	IsOpen      types.Bool         `tfsdk:"is_open"`
+}
+
+type CamundaClusterIPWhitelistDataSource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaClusterIPWhitelistDataSource() datasource.DataSource {
+	return &CamundaClusterIPWhitelistDataSource{}
+}
+
+func (d *CamundaClusterIPWhitelistDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_ip_whitelist"
+}
+
+func (d *CamundaClusterIPWhitelistDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the IP whitelist of a Camunda cluster",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID",
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Cluster ID",
+				Required:            true,
+			},
+			"ip_whitelist": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "The whitelisted IP address/network",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of this IP whitelist entry",
+							Computed:            true,
+						},
+					},
+				},
+				MarkdownDescription: "The IP whitelist entries configured on the cluster",
+				Computed:            true,
+			},
+			"is_open": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cluster accepts connections from any IP address, either because no whitelist is configured or because an entry (such as `0.0.0.0/0`) covers the whole internet",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CamundaClusterIPWhitelistDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *CamundaClusterIPWhitelistDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data clusterIPWhitelistDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := data.ClusterID.ValueString()
+	ctx = context.WithValue(ctx, console.ContextAccessToken, d.provider.accessToken)
+
+	cluster, _, err := d.provider.client.DefaultAPI.GetCluster(ctx, clusterId).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read cluster ID=%s, got error: %s", clusterId, formatClientError(err)),
+		)
+		return
+	}
+
+	ipWhitelist := []ipWhitelistModel{}
+	for _, item := range cluster.Ipwhitelist {
+		ipWhitelist = append(ipWhitelist, ipWhitelistModel{
+			IP:          types.StringValue(item.Ip),
+			Description: types.StringValue(item.Description),
+		})
+	}
+
+	data.Id = types.StringValue(clusterId)
+	data.IPWhitelist = ipWhitelist
+	data.IsOpen = types.BoolValue(isOpenIPWhitelist(cluster.Ipwhitelist))
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// isOpenIPWhitelist reports whether the given whitelist accepts connections
+// from any IP address. An empty whitelist does not restrict incoming
+// connections.
+func isOpenIPWhitelist(whitelist []console.ClusterIpallowlistInner) bool {
+	if len(whitelist) == 0 {
+		return true
+	}
+
+	for _, item := range whitelist {
+		if isOpenIPNetwork(item.Ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isOpenIPNetwork reports whether the given whitelist entry matches every
+// address of its IP family, such as `0.0.0.0/0` or `::/0`.
+func isOpenIPNetwork(value string) bool {
+	_, network, err := net.ParseCIDR(value)
+	if err != nil {
+		return false
+	}
+
+	ones, _ := network.Mask.Size()
+	return ones == 0
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"testing"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCamundaClusterIPWhitelistDataSource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+	api.addCluster("restricted", console.ClusterIpallowlistInner{Ip: "10.0.0.0/8", Description: "office"})
+	api.addCluster("open", console.ClusterIpallowlistInner{Ip: "10.0.0.0/8", Description: "office"}, console.ClusterIpallowlistInner{Ip: "::/0", Description: "anyone"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: api.providerConfig() + `
+data "camunda_cluster_ip_whitelist" "restricted" {
+  cluster_id = "restricted"
+}
+
+data "camunda_cluster_ip_whitelist" "open" {
+  cluster_id = "open"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.camunda_cluster_ip_whitelist.restricted", "id", "restricted"),
+					resource.TestCheckResourceAttr("data.camunda_cluster_ip_whitelist.restricted", "ip_whitelist.#", "1"),
+					resource.TestCheckResourceAttr("data.camunda_cluster_ip_whitelist.restricted", "ip_whitelist.0.ip", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("data.camunda_cluster_ip_whitelist.restricted", "ip_whitelist.0.description", "office"),
+					resource.TestCheckResourceAttr("data.camunda_cluster_ip_whitelist.restricted", "is_open", "false"),
+					resource.TestCheckResourceAttr("data.camunda_cluster_ip_whitelist.open", "ip_whitelist.#", "2"),
+					resource.TestCheckResourceAttr("data.camunda_cluster_ip_whitelist.open", "is_open", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestIsOpenIPWhitelist(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ips    []string
+		expect bool
+	}{
+		"empty whitelist": {
+			expect: true,
+		},
+		"restricted networks": {
+			ips:    []string{"10.0.0.0/8", "192.168.0.1/32", "2001:db8::/32"},
+			expect: false,
+		},
+		"open IPv4 network": {
+			ips:    []string{"10.0.0.0/8", "0.0.0.0/0"},
+			expect: true,
+		},
+		"open IPv6 network": {
+			ips:    []string{"::/0"},
+			expect: true,
+		},
+		"unparsable entry": {
+			ips:    []string{"anywhere"},
+			expect: false,
+		},
+		"single address": {
+			ips:    []string{"0.0.0.0"},
+			expect: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			whitelist := []console.ClusterIpallowlistInner{}
+			for _, ip := range testCase.ips {
+				whitelist = append(whitelist, console.ClusterIpallowlistInner{Ip: ip})
+			}
+
+			if isOpen := isOpenIPWhitelist(whitelist); isOpen != testCase.expect {
+				t.Errorf("expected %v to be open: %t, got %t", testCase.ips, testCase.expect, isOpen)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/camunda-community-hub/terraform-provider-camunda/internal/validators"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -20,6 +21,8 @@ import (
 
 var _ resource.Resource = &CamundaClusterIPWhiteListResource{}
 var _ resource.ResourceWithImportState = &CamundaClusterIPWhiteListResource{}
+var _ resource.ResourceWithIdentity = &CamundaClusterIPWhiteListResource{}
+var _ resource.ResourceWithUpgradeState = &CamundaClusterIPWhiteListResource{}
 
 type camundaClusterIPWhitelistData struct {
 	Id          types.String       `tfsdk:"id"`
@@ -27,6 +30,10 @@ type camundaClusterIPWhitelistData struct {
 	IPWhitelist []ipWhitelistModel `tfsdk:"ip_whitelist"`
 }
 
+type camundaClusterIPWhitelistIdentity struct {
+	ClusterID types.String `tfsdk:"cluster_id"`
+}
+
 type ipWhitelistModel struct {
 	IP          types.String `tfsdk:"ip"`
 	Description types.String `tfsdk:"description"`
@@ -47,16 +54,18 @@ func (r *CamundaClusterIPWhiteListResource) Metadata(ctx context.Context, req re
 func (r *CamundaClusterIPWhiteListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manage IP whitelists of a Camunda cluster",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "ID",
+				MarkdownDescription: "ID, always equal to `cluster_id`",
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"cluster_id": schema.StringAttribute{
-				MarkdownDescription: "Cluster ID",
+				MarkdownDescription: "Cluster ID. Changing it clears the IP whitelist of the previous cluster and configures the new one.",
 				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 		},
 		Blocks: map[string]schema.Block{
@@ -83,6 +92,17 @@ func (r *CamundaClusterIPWhiteListResource) Schema(ctx context.Context, req reso
 	}
 }
 
+func (r *CamundaClusterIPWhiteListResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"cluster_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Cluster ID",
+			},
+		},
+	}
+}
+
 func (r *CamundaClusterIPWhiteListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Provider not yet configured
 	if req.ProviderData == nil {
@@ -131,12 +151,8 @@ func (r *CamundaClusterIPWhiteListResource) Create(ctx context.Context, req reso
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
-	diags = resp.State.SetAttribute(ctx, ipWhitelistPath, data.IPWhitelist)
+	diags = resp.Identity.Set(ctx, camundaClusterIPWhitelistIdentity{ClusterID: data.ClusterID})
 	resp.Diagnostics.Append(diags...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
 }
 
 func (r *CamundaClusterIPWhiteListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -149,10 +165,11 @@ func (r *CamundaClusterIPWhiteListResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	clusterId := ipWhitelistClusterID(data)
 	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
 
-	cluster, response, err := r.provider.client.DefaultAPI.GetCluster(ctx, data.Id.ValueString()).Execute()
-	if err != nil && response.StatusCode == http.StatusNotFound {
+	cluster, response, err := r.provider.client.DefaultAPI.GetCluster(ctx, clusterId).Execute()
+	if err != nil && response != nil && response.StatusCode == http.StatusNotFound {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -160,7 +177,7 @@ func (r *CamundaClusterIPWhiteListResource) Read(ctx context.Context, req resour
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
-			fmt.Sprintf("Unable to read cluster ID=%s, got error: %s", data.Id.ValueString(), formatClientError(err)),
+			fmt.Sprintf("Unable to read cluster ID=%s, got error: %s", clusterId, formatClientError(err)),
 		)
 		return
 	}
@@ -175,19 +192,15 @@ func (r *CamundaClusterIPWhiteListResource) Read(ctx context.Context, req resour
 		ipWhitelist = append(ipWhitelist, ipDesc)
 	}
 
+	data.Id = types.StringValue(clusterId)
+	data.ClusterID = types.StringValue(clusterId)
 	data.IPWhitelist = ipWhitelist
 
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	diags = resp.Identity.Set(ctx, camundaClusterIPWhitelistIdentity{ClusterID: data.ClusterID})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CamundaClusterIPWhiteListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -200,7 +213,7 @@ func (r *CamundaClusterIPWhiteListResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	clusterId := data.Id.ValueString()
+	clusterId := data.ClusterID.ValueString()
 	ipWhitelistPath := path.Root("ip_whitelist")
 
 	err := r.configureIPWhitelisting(ctx, data, clusterId)
@@ -213,6 +226,7 @@ func (r *CamundaClusterIPWhiteListResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	data.Id = types.StringValue(clusterId)
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -227,21 +241,36 @@ func (r *CamundaClusterIPWhiteListResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
-	clusterId := data.ClusterID.ValueString()
+	clusterId := ipWhitelistClusterID(data)
+
+	// Removing the resource opens the cluster again, so push an empty whitelist
+	// instead of the one from the state.
+	data.IPWhitelist = nil
 
 	err := r.configureIPWhitelisting(ctx, data, clusterId)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
-			fmt.Sprintf("Unable to remove IP whitelisting from cluster ID=%s, got error: %s", data.Id.ValueString(), formatClientError(err)),
+			fmt.Sprintf("Unable to remove IP whitelisting from cluster ID=%s, got error: %s", clusterId, err),
 		)
 		return
 	}
 }
 
 func (r *CamundaClusterIPWhiteListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("cluster_id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("cluster_id"), path.Root("cluster_id"), req, resp)
+}
+
+// ipWhitelistClusterID returns the ID of the cluster the whitelist belongs to.
+// States written by older versions of the provider, or imported by ID, may only
+// carry the `id` attribute.
+func ipWhitelistClusterID(data camundaClusterIPWhitelistData) string {
+	if data.ClusterID.IsNull() || data.ClusterID.ValueString() == "" {
+		return data.Id.ValueString()
+	}
+
+	return data.ClusterID.ValueString()
 }
 
 func (r *CamundaClusterIPWhiteListResource) configureIPWhitelisting(ctx context.Context, data camundaClusterIPWhitelistData, clusterID string) error {
@@ -266,16 +295,76 @@ func (r *CamundaClusterIPWhiteListResource) configureIPWhitelisting(ctx context.
 		Execute()
 
 	if err != nil {
-		return fmt.Errorf("unable to create cluster, got error: %s", formatClientError(err))
+		return fmt.Errorf("unable to configure IP whitelisting, got error: %s", formatClientError(err))
 	}
 
-	if response.StatusCode != 204 {
-		return fmt.Errorf("error while configuring IP whitelisting, expected HTTP 200, got: %d", response.StatusCode)
+	if response.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("error while configuring IP whitelisting, expected HTTP 204, got: %d", response.StatusCode)
 	}
 
 	tflog.Info(ctx, "IP Whitelisting configured", map[string]interface{}{
-		"clusterID": data.Id,
+		"clusterID": clusterID,
 	})
 
 	return nil
 }
+
+func (r *CamundaClusterIPWhiteListResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: stateUpgrader(camundaClusterIPWhitelistSchemaV0, upgradeCamundaClusterIPWhitelistStateV0),
+	}
+}
+
+// camundaClusterIPWhitelistDataV0 is the state of IP whitelists before
+// schemas were versioned.
+type camundaClusterIPWhitelistDataV0 struct {
+	Id          types.String         `tfsdk:"id"`
+	ClusterID   types.String         `tfsdk:"cluster_id"`
+	IPWhitelist []ipWhitelistModelV0 `tfsdk:"ip_whitelist"`
+}
+
+type ipWhitelistModelV0 struct {
+	IP          types.String `tfsdk:"ip"`
+	Description types.String `tfsdk:"description"`
+}
+
+var camundaClusterIPWhitelistSchemaV0 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id":         schema.StringAttribute{Computed: true},
+		"cluster_id": schema.StringAttribute{Required: true},
+	},
+	Blocks: map[string]schema.Block{
+		"ip_whitelist": schema.SetNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"description": schema.StringAttribute{Optional: true, Computed: true},
+					"ip":          schema.StringAttribute{Required: true},
+				},
+			},
+		},
+	},
+}
+
+// upgradeCamundaClusterIPWhitelistStateV0 fills in the cluster ID of IP
+// whitelists imported by older versions of the provider, which only set the
+// ID.
+func upgradeCamundaClusterIPWhitelistStateV0(prior camundaClusterIPWhitelistDataV0) camundaClusterIPWhitelistData {
+	data := camundaClusterIPWhitelistData{
+		Id:          prior.Id,
+		ClusterID:   prior.ClusterID,
+		IPWhitelist: []ipWhitelistModel{},
+	}
+
+	if data.ClusterID.IsNull() {
+		data.ClusterID = data.Id
+	}
+
+	for _, item := range prior.IPWhitelist {
+		data.IPWhitelist = append(data.IPWhitelist, ipWhitelistModel{
+			IP:          item.IP,
+			Description: item.Description,
+		})
+	}
+
+	return data
+}
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func newTestIPWhitelistResource(t *testing.T, api *fakeConsoleAPI) (*CamundaClusterIPWhiteListResource, schema.Schema) {
+	t.Helper()
+
+	r := &CamundaClusterIPWhiteListResource{provider: api.provider()}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", schemaResp.Diagnostics)
+	}
+
+	return r, schemaResp.Schema
+}
+
+func emptyState(s schema.Schema) tfsdk.State {
+	return tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(context.Background()), nil),
+	}
+}
+
+func stateFrom(t *testing.T, s schema.Schema, data interface{}) tfsdk.State {
+	t.Helper()
+
+	state := emptyState(s)
+	if diags := state.Set(context.Background(), data); diags.HasError() {
+		t.Fatalf("unable to build state: %v", diags)
+	}
+
+	return state
+}
+
+func planFrom(t *testing.T, s schema.Schema, data interface{}) tfsdk.Plan {
+	t.Helper()
+
+	state := stateFrom(t, s, data)
+	return tfsdk.Plan{Schema: s, Raw: state.Raw}
+}
+
+func TestCamundaClusterIPWhitelistResourceClusterIDRequiresReplace(t *testing.T) {
+	_, s := newTestIPWhitelistResource(t, newFakeConsoleAPI(t))
+
+	clusterID, ok := s.Attributes["cluster_id"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("cluster_id is not a string attribute")
+	}
+
+	want := stringplanmodifier.RequiresReplace().Description(context.Background())
+	for _, modifier := range clusterID.PlanModifiers {
+		if modifier.Description(context.Background()) == want {
+			return
+		}
+	}
+
+	t.Errorf("cluster_id must require replacement when changed")
+}
+
+func TestCamundaClusterIPWhitelistResourceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	api.addCluster("cluster-1")
+
+	r, s := newTestIPWhitelistResource(t, api)
+
+	planned := camundaClusterIPWhitelistData{
+		Id:        types.StringUnknown(),
+		ClusterID: types.StringValue("cluster-1"),
+		IPWhitelist: []ipWhitelistModel{
+			{IP: types.StringValue("10.0.0.0/8"), Description: types.StringValue("office")},
+		},
+	}
+
+	createResp := resource.CreateResponse{State: emptyState(s), Identity: testResourceIdentity(t, r)}
+	r.Create(ctx, resource.CreateRequest{Plan: planFrom(t, s, planned)}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+
+	var created camundaClusterIPWhitelistData
+	createResp.State.Get(ctx, &created)
+	if created.Id.ValueString() != "cluster-1" {
+		t.Errorf("expected id to be derived from cluster_id, got %q", created.Id.ValueString())
+	}
+
+	if got := api.ipWhitelist("cluster-1"); len(got) != 1 || got[0].Ip != "10.0.0.0/8" {
+		t.Errorf("unexpected IP whitelist after create: %v", got)
+	}
+
+	readResp := resource.ReadResponse{State: createResp.State, Identity: createResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	deleteResp := resource.DeleteResponse{State: readResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", deleteResp.Diagnostics)
+	}
+
+	if got := api.ipWhitelist("cluster-1"); len(got) != 0 {
+		t.Errorf("expected the IP whitelist to be cleared on delete, got %v", got)
+	}
+}
+
+func TestCamundaClusterIPWhitelistResourceImport(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	api.addCluster("cluster-1", *console.NewClusterIpallowlistInner("vpn", "192.168.0.0/24"))
+
+	r, s := newTestIPWhitelistResource(t, api)
+
+	importResp := resource.ImportStateResponse{State: emptyState(s), Identity: testResourceIdentity(t, r)}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "cluster-1"}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected import diagnostics: %v", importResp.Diagnostics)
+	}
+
+	readResp := resource.ReadResponse{State: importResp.State, Identity: importResp.Identity}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var imported camundaClusterIPWhitelistData
+	readResp.State.Get(ctx, &imported)
+
+	if imported.Id.ValueString() != "cluster-1" || imported.ClusterID.ValueString() != "cluster-1" {
+		t.Errorf("expected id and cluster_id to be set on import, got id=%q cluster_id=%q",
+			imported.Id.ValueString(), imported.ClusterID.ValueString())
+	}
+
+	if len(imported.IPWhitelist) != 1 || imported.IPWhitelist[0].IP.ValueString() != "192.168.0.0/24" {
+		t.Errorf("unexpected IP whitelist after import: %v", imported.IPWhitelist)
+	}
+}
+
+func TestCamundaClusterIPWhitelistResourceReadLegacyState(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	api.addCluster("cluster-1")
+
+	r, s := newTestIPWhitelistResource(t, api)
+
+	// Imports made by previous versions of the provider only set `id`.
+	state := stateFrom(t, s, camundaClusterIPWhitelistData{
+		Id:        types.StringValue("cluster-1"),
+		ClusterID: types.StringNull(),
+	})
+
+	readResp := resource.ReadResponse{State: state, Identity: testResourceIdentity(t, r)}
+	r.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	var data camundaClusterIPWhitelistData
+	readResp.State.Get(ctx, &data)
+
+	if data.ClusterID.ValueString() != "cluster-1" {
+		t.Errorf("expected cluster_id to be populated from id, got %q", data.ClusterID.ValueString())
+	}
+}
+
+func TestAccCamundaClusterIPWhitelistResource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	office := `
+  ip_whitelist {
+    ip          = "10.0.0.0/8"
+    description = "office"
+  }
+`
+	vpn := `
+  ip_whitelist {
+    ip          = "192.168.0.1/32"
+    description = "vpn"
+  }
+`
+
+	sdkresource.Test(t, sdkresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []sdkresource.TestStep{
+			// Create and Read testing
+			{
+				Config: api.providerConfig() + testAccCamundaClusterIPWhitelistResourceConfig(office),
+				Check: sdkresource.ComposeAggregateTestCheckFunc(
+					sdkresource.TestCheckResourceAttrPair("camunda_cluster_ip_whitelist.test", "id", "camunda_cluster.test", "id"),
+					sdkresource.TestCheckResourceAttr("camunda_cluster_ip_whitelist.test", "ip_whitelist.#", "1"),
+					sdkresource.TestCheckTypeSetElemNestedAttrs("camunda_cluster_ip_whitelist.test", "ip_whitelist.*", map[string]string{
+						"ip":          "10.0.0.0/8",
+						"description": "office",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "camunda_cluster_ip_whitelist.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: api.providerConfig() + testAccCamundaClusterIPWhitelistResourceConfig(office+vpn),
+				Check: sdkresource.ComposeAggregateTestCheckFunc(
+					sdkresource.TestCheckResourceAttr("camunda_cluster_ip_whitelist.test", "ip_whitelist.#", "2"),
+					sdkresource.TestCheckTypeSetElemNestedAttrs("camunda_cluster_ip_whitelist.test", "ip_whitelist.*", map[string]string{
+						"ip":          "192.168.0.1/32",
+						"description": "vpn",
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCamundaClusterIPWhitelistResourceConfig(entries string) string {
+	return testAccClusterConfig("tf-acc-cluster") + fmt.Sprintf(`
+resource "camunda_cluster_ip_whitelist" "test" {
+  cluster_id = camunda_cluster.test.id
+%s}
+`, entries)
+}
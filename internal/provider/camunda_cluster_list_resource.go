@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &CamundaClusterListResource{}
+var _ list.ListResourceWithConfigure = &CamundaClusterListResource{}
+
+type CamundaClusterListResource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaClusterListResource() list.ListResource {
+	return &CamundaClusterListResource{}
+}
+
+func (r *CamundaClusterListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+func (r *CamundaClusterListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the clusters of the organization",
+	}
+}
+
+func (r *CamundaClusterListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider = configureListResource(req, resp)
+}
+
+func (r *CamundaClusterListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+
+	clusters, _, err := r.provider.client.DefaultAPI.GetClusters(ctx).Execute()
+	if err != nil {
+		stream.Results = listError("Client Error", fmt.Sprintf("Unable to list clusters, got error: %s", formatClientError(err)))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, clusters, func(cluster console.Cluster, result *list.ListResult) {
+		result.DisplayName = cluster.Name
+		result.Diagnostics.Append(result.Identity.Set(ctx, camundaClusterIdentity{Id: types.StringValue(cluster.Uuid)})...)
+
+		if req.IncludeResource {
+			var data camundaClusterData
+			result.Diagnostics.Append(data.setCluster(ctx, &cluster, r.provider.defaultLabels)...)
+			data.setDefaults()
+			result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+		}
+	})
+}
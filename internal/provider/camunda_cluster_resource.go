@@ -3,30 +3,60 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/http"
 	"time"
 
 	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
 var _ resource.Resource = &CamundaClusterResource{}
 var _ resource.ResourceWithImportState = &CamundaClusterResource{}
+var _ resource.ResourceWithModifyPlan = &CamundaClusterResource{}
+var _ resource.ResourceWithIdentity = &CamundaClusterResource{}
+var _ resource.ResourceWithUpgradeState = &CamundaClusterResource{}
+
+// How long to wait before first polling the status of a new cluster, the
+// minimum interval between polls, and how long to wait for it to be healthy.
+var (
+	clusterStatusDelay        = 10 * time.Second
+	clusterStatusPollInterval = 5 * time.Second
+	clusterCreateTimeout      = 30 * time.Minute
+)
 
 type camundaClusterData struct {
-	Id         types.String `tfsdk:"id"`
-	Name       types.String `tfsdk:"name"`
-	Channel    types.String `tfsdk:"channel"`
-	Region     types.String `tfsdk:"region"`
-	PlanType   types.String `tfsdk:"plan_type"`
-	Generation types.String `tfsdk:"generation"`
+	Id                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	Channel               types.String `tfsdk:"channel"`
+	Region                types.String `tfsdk:"region"`
+	PlanType              types.String `tfsdk:"plan_type"`
+	Generation            types.String `tfsdk:"generation"`
+	Stage                 types.String `tfsdk:"stage"`
+	Labels                types.Map    `tfsdk:"labels"`
+	AllLabels             types.Map    `tfsdk:"all_labels"`
+	DeletionProtection    types.Bool   `tfsdk:"deletion_protection"`
+	WaitForHealthy        types.Bool   `tfsdk:"wait_for_healthy"`
+	WaitForComponents     types.Set    `tfsdk:"wait_for_components"`
+	DeleteOnCreateFailure types.Bool   `tfsdk:"delete_on_create_failure"`
+}
+
+type camundaClusterIdentity struct {
+	Id types.String `tfsdk:"id"`
 }
 
 type CamundaClusterResource struct {
@@ -44,6 +74,7 @@ func (r *CamundaClusterResource) Metadata(ctx context.Context, req resource.Meta
 func (r *CamundaClusterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manage a cluster on Camunda SaaS",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -73,6 +104,66 @@ func (r *CamundaClusterResource) Schema(ctx context.Context, req resource.Schema
 				MarkdownDescription: "Generation",
 				Required:            true,
 			},
+			"stage": schema.StringAttribute{
+				MarkdownDescription: "The stage of the cluster: `dev`, `test`, `stage` or `prod`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.OneOf(clusterStages...),
+				},
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Labels of the cluster, merged with the `default_labels` of the provider.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"all_labels": schema.MapAttribute{
+				MarkdownDescription: "All the labels of the cluster, including the `default_labels` of the provider.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether to prevent the cluster from being destroyed. It must be set to `false`, and applied, before the cluster can be destroyed. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_for_healthy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait, on creation, until the cluster is healthy. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"wait_for_components": schema.SetAttribute{
+				MarkdownDescription: "The components of the cluster to wait for, on creation, besides its overall status: `zeebe`, `operate`, `tasklist`, `optimize` or `connectors`. Only used if `wait_for_healthy` is set. Defaults to none.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(clusterComponents...),
+					),
+				},
+			},
+			"delete_on_create_failure": schema.BoolAttribute{
+				MarkdownDescription: "Whether to delete the cluster when it does not get healthy on creation, instead of keeping it as tainted. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *CamundaClusterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Cluster ID",
+			},
 		},
 	}
 }
@@ -107,12 +198,27 @@ func (r *CamundaClusterResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	allLabels, diags := data.mergeLabels(ctx, r.provider.defaultLabels)
+	resp.Diagnostics.Append(diags...)
+
+	data.AllLabels, diags = types.MapValueFrom(ctx, types.StringType, allLabels)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	newClusterConfiguration := console.CreateClusterRequest{
 		Name:         data.Name.ValueString(),
 		PlanTypeId:   data.PlanType.ValueString(),
 		ChannelId:    data.Channel.ValueString(),
 		GenerationId: data.Generation.ValueString(),
 		RegionId:     data.Region.ValueString(),
+		Labels:       allLabels,
+	}
+
+	if !data.Stage.IsUnknown() {
+		newClusterConfiguration.Stage = data.Stage.ValueStringPointer()
 	}
 
 	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
@@ -132,6 +238,11 @@ func (r *CamundaClusterResource) Create(ctx context.Context, req resource.Create
 	clusterId := inline.GetClusterId()
 	data.Id = types.StringValue(clusterId)
 
+	// The stage is only known once the cluster is created.
+	if data.Stage.IsUnknown() {
+		data.Stage = types.StringNull()
+	}
+
 	tflog.Info(ctx, "Camunda cluster created", map[string]interface{}{
 		"clusterID": data.Id,
 	})
@@ -139,53 +250,50 @@ func (r *CamundaClusterResource) Create(ctx context.Context, req resource.Create
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
-	// Creating a cluster takes some time, wait until it's marked healthy.
-	createState := &retry.StateChangeConf{
-		// The cluster states that we need to keep waiting on
-		Pending: []string{
-			string(console.CLUSTERCOMPONENTSTATUS_CREATING),
-			string(console.CLUSTERCOMPONENTSTATUS_UPDATING),
-		},
-
-		// The cluster states that we would like to reach
-		Target: []string{
-			string(console.CLUSTERCOMPONENTSTATUS_HEALTHY),
-		},
-
-		// How many times the target state has to be reached to continue.
-		ContinuousTargetOccurence: 2,
-
-		Refresh: func() (interface{}, string, error) {
-			cluster, _, err := r.provider.client.DefaultAPI.
-				GetCluster(ctx, clusterId).
-				Execute()
-
-			if err != nil {
-				return nil, "", err
-			}
+	diags = resp.Identity.Set(ctx, camundaClusterIdentity{Id: data.Id})
+	resp.Diagnostics.Append(diags...)
 
-			tflog.Info(ctx, "Camunda cluster status", map[string]interface{}{
-				"clusterID":     cluster.Uuid,
-				"clusterStatus": cluster.Status.Ready,
-			})
+	if !data.WaitForHealthy.ValueBool() {
+		return
+	}
 
-			return cluster, string(cluster.Status.Ready), nil
-		},
+	var components []string
+	diags = data.WaitForComponents.ElementsAs(ctx, &components, false)
+	resp.Diagnostics.Append(diags...)
 
-		Timeout:    30 * time.Minute,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	_, err = createState.WaitForStateContext(ctx)
+	// Creating a cluster takes some time, wait until it's marked healthy.
+	cluster, err := waitForClusterHealthy(ctx, r.provider, clusterId, components, []console.ClusterComponentStatus{
+		console.CLUSTERCOMPONENTSTATUS_CREATING,
+		console.CLUSTERCOMPONENTSTATUS_UPDATING,
+	}, clusterCreateTimeout)
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to create cluster",
-			fmt.Sprintf("Cluster %s never got healthy; got error: %s", clusterId, err),
-		)
+		detail := fmt.Sprintf("Cluster %s never got healthy; got error: %s", clusterId, err)
+		if cluster != nil {
+			detail += fmt.Sprintf("\n\nLast observed status: %s", describeClusterStatus(cluster.Status))
+		}
+
+		if data.DeleteOnCreateFailure.ValueBool() {
+			if _, err := r.provider.client.DefaultAPI.DeleteCluster(ctx, clusterId).Execute(); err != nil {
+				detail += fmt.Sprintf("\n\nUnable to delete the cluster, got error: %s", formatClientError(err))
+			} else {
+				detail += "\n\nThe cluster was deleted."
+				resp.State.RemoveResource(ctx)
+			}
+		}
+
+		resp.Diagnostics.AddError("Unable to create cluster", detail)
 		return
 	}
+
+	data.Stage = types.StringPointerValue(cluster.Stage)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CamundaClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -201,7 +309,7 @@ func (r *CamundaClusterResource) Read(ctx context.Context, req resource.ReadRequ
 	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
 
 	cluster, response, err := r.provider.client.DefaultAPI.GetCluster(ctx, data.Id.ValueString()).Execute()
-	if err != nil && response.StatusCode == http.StatusNotFound {
+	if err != nil && response != nil && response.StatusCode == http.StatusNotFound {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -214,26 +322,82 @@ func (r *CamundaClusterResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	data.Name = types.StringValue(cluster.Name)
-	data.Channel = types.StringValue(cluster.Channel.Uuid)
-	data.Region = types.StringValue(cluster.Region.Uuid)
-	data.PlanType = types.StringValue(cluster.PlanType.Uuid)
-	data.Generation = types.StringValue(cluster.Generation.Uuid)
+	diags = data.setCluster(ctx, cluster, r.provider.defaultLabels)
+	resp.Diagnostics.Append(diags...)
+
+	data.setDefaults()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if cluster.Status.Ready == console.CLUSTERCOMPONENTSTATUS_UNHEALTHY {
+		resp.Diagnostics.AddWarning(
+			"Cluster is unhealthy",
+			fmt.Sprintf("Cluster ID=%s is unhealthy (%s). Hibernated clusters can be woken up with the camunda_cluster_wake action.",
+				data.Id.ValueString(), describeClusterStatus(cluster.Status)),
+		)
+	}
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
+
+	diags = resp.Identity.Set(ctx, camundaClusterIdentity{Id: data.Id})
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CamundaClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data camundaClusterData
+	var data, state camundaClusterData
 
 	diags := req.Plan.Get(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allLabels, diags := data.mergeLabels(ctx, r.provider.defaultLabels)
+	resp.Diagnostics.Append(diags...)
+
+	data.AllLabels, diags = types.MapValueFrom(ctx, types.StringType, allLabels)
+	resp.Diagnostics.Append(diags...)
+
+	var currentLabels map[string]string
+	diags = state.AllLabels.ElementsAs(ctx, &currentLabels, false)
+	resp.Diagnostics.Append(diags...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Clusters without a stage keep none unless one is configured.
+	if data.Stage.IsUnknown() {
+		data.Stage = state.Stage
+	}
+
+	// Only the stage and the labels of a cluster can be updated in place.
+	if !data.Stage.Equal(state.Stage) || !maps.Equal(allLabels, currentLabels) {
+		ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+
+		_, err := r.provider.client.DefaultAPI.UpdateCluster(ctx, data.Id.ValueString()).
+			UpdateClusterRequest(console.UpdateClusterRequest{
+				Stage:  data.Stage.ValueStringPointer(),
+				Labels: allLabels,
+			}).
+			Execute()
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to update cluster ID=%s, got error: %s", data.Id.ValueString(), formatClientError(err)),
+			)
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -248,6 +412,14 @@ func (r *CamundaClusterResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Cluster is protected from deletion",
+			fmt.Sprintf("Cluster ID=%s has deletion_protection enabled. Set deletion_protection to false and apply the change before destroying the cluster.", data.Id.ValueString()),
+		)
+		return
+	}
+
 	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
 
 	_, err := r.provider.client.DefaultAPI.DeleteCluster(ctx, data.Id.ValueString()).Execute()
@@ -260,6 +432,195 @@ func (r *CamundaClusterResource) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+func (r *CamundaClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when destroying the cluster, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.provider == nil {
+		return
+	}
+
+	var plan, state camundaClusterData
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if !req.State.Raw.IsNull() {
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Merge the default labels of the provider, so that changing them updates
+	// the clusters. They are unknown until the labels of the cluster are.
+	if !plan.Labels.IsUnknown() {
+		allLabels, diags := plan.mergeLabels(ctx, r.provider.defaultLabels)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		diags = resp.Plan.SetAttribute(ctx, path.Root("all_labels"), allLabels)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Only validate the values that are about to be sent to the API: existing
+	// clusters may run on a generation that is not offered anymore.
+	changed := func(planned, current types.String) bool {
+		return !planned.IsUnknown() && !planned.IsNull() && !planned.Equal(current)
+	}
+
+	if !changed(plan.Channel, state.Channel) && !changed(plan.Region, state.Region) &&
+		!changed(plan.PlanType, state.PlanType) && !changed(plan.Generation, state.Generation) {
+		return
+	}
+
+	params, err := r.provider.parameters(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read parameters, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	if changed(plan.Region, state.Region) && !hasRegion(params, plan.Region.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Unknown region",
+			fmt.Sprintf("Region ID '%s' is not available. Use the `camunda_region` data source to look up its ID.", plan.Region.ValueString()),
+		)
+	}
+
+	if changed(plan.PlanType, state.PlanType) && !hasClusterPlanType(params, plan.PlanType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("plan_type"),
+			"Unknown cluster plan type",
+			fmt.Sprintf("Cluster plan type ID '%s' is not available. Use the `camunda_cluster_plan_type` data source to look up its ID.", plan.PlanType.ValueString()),
+		)
+	}
+
+	channel := findChannel(params, plan.Channel.ValueString())
+	if changed(plan.Channel, state.Channel) && channel == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("channel"),
+			"Unknown channel",
+			fmt.Sprintf("Channel ID '%s' is not available. Use the `camunda_channel` data source to look up its ID.", plan.Channel.ValueString()),
+		)
+	}
+
+	if changed(plan.Generation, state.Generation) && channel != nil && !channelAllowsGeneration(*channel, plan.Generation.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("generation"),
+			"Generation not allowed",
+			fmt.Sprintf("Generation ID '%s' is not allowed on channel '%s'. Use the `allowed_generations` of the `camunda_channel` data source.",
+				plan.Generation.ValueString(), channel.Name),
+		)
+	}
+}
+
+// setCluster sets the attributes read from the API, telling the labels of the
+// cluster apart from the default labels of the provider.
+func (data *camundaClusterData) setCluster(ctx context.Context, cluster *console.Cluster, defaultLabels map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(cluster.Uuid)
+	data.Name = types.StringValue(cluster.Name)
+	data.Channel = types.StringValue(cluster.Channel.Uuid)
+	data.Region = types.StringValue(cluster.Region.Uuid)
+	data.PlanType = types.StringValue(cluster.PlanType.Uuid)
+	data.Generation = types.StringValue(cluster.Generation.Uuid)
+	data.Stage = types.StringPointerValue(cluster.Stage)
+
+	var configured map[string]string
+	if !data.Labels.IsNull() && !data.Labels.IsUnknown() {
+		configured = map[string]string{}
+		diags.Append(data.Labels.ElementsAs(ctx, &configured, false)...)
+	}
+
+	labels, d := types.MapValueFrom(ctx, types.StringType, resourceLabels(cluster.Labels, defaultLabels, configured))
+	diags.Append(d...)
+	data.Labels = labels
+
+	allLabels, d := types.MapValueFrom(ctx, types.StringType, mergeLabels(nil, cluster.Labels))
+	diags.Append(d...)
+	data.AllLabels = allLabels
+
+	return diags
+}
+
+// setDefaults sets the defaults of the attributes that are not read from the
+// API, which are missing from imported clusters.
+func (data *camundaClusterData) setDefaults() {
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+	if data.WaitForHealthy.IsNull() {
+		data.WaitForHealthy = types.BoolValue(true)
+	}
+	if data.WaitForComponents.IsNull() {
+		data.WaitForComponents = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+	if data.DeleteOnCreateFailure.IsNull() {
+		data.DeleteOnCreateFailure = types.BoolValue(false)
+	}
+}
+
+// mergeLabels returns all the labels of the cluster, including defaultLabels.
+func (data *camundaClusterData) mergeLabels(ctx context.Context, defaultLabels map[string]string) (map[string]string, diag.Diagnostics) {
+	var labels map[string]string
+	diags := data.Labels.ElementsAs(ctx, &labels, false)
+
+	return mergeLabels(defaultLabels, labels), diags
+}
+
 func (r *CamundaClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
+
+func (r *CamundaClusterResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: stateUpgrader(camundaClusterSchemaV0, upgradeCamundaClusterStateV0),
+	}
+}
+
+// camundaClusterDataV0 is the state of clusters before schemas were versioned.
+type camundaClusterDataV0 struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Channel    types.String `tfsdk:"channel"`
+	Region     types.String `tfsdk:"region"`
+	PlanType   types.String `tfsdk:"plan_type"`
+	Generation types.String `tfsdk:"generation"`
+}
+
+var camundaClusterSchemaV0 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id":         schema.StringAttribute{Computed: true},
+		"name":       schema.StringAttribute{Required: true},
+		"channel":    schema.StringAttribute{Required: true},
+		"region":     schema.StringAttribute{Required: true},
+		"plan_type":  schema.StringAttribute{Required: true},
+		"generation": schema.StringAttribute{Required: true},
+	},
+}
+
+func upgradeCamundaClusterStateV0(prior camundaClusterDataV0) camundaClusterData {
+	return camundaClusterData{
+		Id:                    prior.Id,
+		Name:                  prior.Name,
+		Channel:               prior.Channel,
+		Region:                prior.Region,
+		PlanType:              prior.PlanType,
+		Generation:            prior.Generation,
+		Stage:                 types.StringNull(),
+		Labels:                types.MapNull(types.StringType),
+		AllLabels:             types.MapNull(types.StringType),
+		DeletionProtection:    types.BoolValue(false),
+		WaitForHealthy:        types.BoolValue(true),
+		WaitForComponents:     types.SetValueMust(types.StringType, []attr.Value{}),
+		DeleteOnCreateFailure: types.BoolValue(false),
+	}
 }
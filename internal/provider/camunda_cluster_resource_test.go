@@ -1,46 +1,435 @@
 package provider
 
-// TODO: adjust, fix and reenable these tests
-// func TestAccExampleResource(t *testing.T) {
-// 	resource.Test(t, resource.TestCase{
-// 		PreCheck:                 func() { testAccPreCheck(t) },
-// 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-// 		Steps:                    []resource.TestStep{
-// 			// Create and Read testing
-// 			{
-// 				Config: testAccExampleResourceConfig("one"),
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-// 					resource.TestCheckResourceAttr("scaffolding_example.test", "configurable_attribute", "one"),
-// 					resource.TestCheckResourceAttr("scaffolding_example.test", "id", "example-id"),
-// 				),
-// 			},
-// 			// ImportState testing
-// 			{
-// 				ResourceName:      "scaffolding_example.test",
-// 				ImportState:       true,
-// 				ImportStateVerify: true,
-// 				// This is not normally necessary, but is here because this
-// 				// example code does not have an actual upstream service.
-// 				// Once the Read method is able to refresh information from
-// 				// the upstream service, this can be removed.
-// 				ImportStateVerifyIgnore: []string{"configurable_attribute"},
-// 			},
-// 			// Update and Read testing
-// 			{
-// 				Config: testAccExampleResourceConfig("two"),
-// 				Check: resource.ComposeAggregateTestCheckFunc(
-// 					resource.TestCheckResourceAttr("scaffolding_example.test", "configurable_attribute", "two"),
-// 				),
-// 			},
-// 			// Delete testing automatically occurs in TestCase
-// 		},
-// 	})
-// }
-
-// func testAccExampleResourceConfig(configurableAttribute string) string {
-// 	return fmt.Sprintf(`
-// resource "scaffolding_example" "test" {
-//   configurable_attribute = %[1]q
-// }
-// `, configurableAttribute)
-// }
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCamundaClusterResource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if count := api.clusterCount(); count != 0 {
+				return fmt.Errorf("expected all clusters to be deleted, %d left", count)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: api.providerConfig() + testAccClusterConfig("tf-acc-cluster"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("camunda_cluster.test", "id"),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "name", "tf-acc-cluster"),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "channel", fakeChannelStableID),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "generation", fakeGeneration86ID),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "region", fakeRegionBelgiumID),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "plan_type", fakePlanTypeTrialID),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "camunda_cluster.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Only the stage and labels of clusters are updated in place, see
+			// TestAccCamundaClusterResourceLabels.
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCamundaClusterResourceLabels(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterLabelsConfig(api, "dev", `env = "dev"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("camunda_cluster.test", "stage", "dev"),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "labels.%", "1"),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "all_labels.%", "2"),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "all_labels.team", "platform"),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "all_labels.env", "dev"),
+				),
+			},
+			// The stage and labels are updated in place.
+			{
+				Config: testAccClusterLabelsConfig(api, "prod", `env = "prod", team = "payments"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("camunda_cluster.test", "stage", "prod"),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "all_labels.%", "2"),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "all_labels.team", "payments"),
+					resource.TestCheckResourceAttr("camunda_cluster.test", "all_labels.env", "prod"),
+				),
+			},
+		},
+	})
+}
+
+// testAccClusterLabelsConfig returns the configuration of a cluster with a
+// stage and labels, created by a provider with default labels.
+func testAccClusterLabelsConfig(api *fakeConsoleAPI, stage string, labels string) string {
+	return fmt.Sprintf(`
+provider "camunda" {
+  client_id     = %[1]q
+  client_secret = %[2]q
+  api_url       = %[3]q
+  token_url     = "%[3]s/oauth/token"
+
+  default_labels = {
+    team = "platform"
+  }
+}
+
+data "camunda_channel" "stable" {
+  name = "Stable"
+}
+
+data "camunda_region" "belgium" {
+  name = "Belgium, Europe (europe-west1)"
+}
+
+data "camunda_cluster_plan_type" "trial" {
+  name = "Trial Cluster"
+}
+
+resource "camunda_cluster" "test" {
+  name       = "tf-acc-labels"
+  channel    = data.camunda_channel.stable.id
+  generation = data.camunda_channel.stable.default_generation.id
+  region     = data.camunda_region.belgium.id
+  plan_type  = data.camunda_cluster_plan_type.trial.id
+  stage      = %[4]q
+  labels     = { %[5]s }
+}
+`, fakeClientID, fakeClientSecret, api.server.URL, stage, labels)
+}
+
+func TestCamundaClusterResourceLabels(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+
+	p := api.provider()
+	p.defaultLabels = map[string]string{"team": "platform", "cost-center": "42"}
+	r := &CamundaClusterResource{provider: p}
+
+	plan := clusterPlan(t, map[string]string{"env": "dev", "cost-center": "7"})
+	plan.Stage = types.StringValue("dev")
+
+	state, diags := testCreate(t, r, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	var data camundaClusterData
+	state.Get(ctx, &data)
+	id := data.Id.ValueString()
+
+	expect := map[string]string{"team": "platform", "cost-center": "7", "env": "dev"}
+	if labels := api.clusterLabels(id); !maps.Equal(labels, expect) {
+		t.Errorf("expected the cluster to be created with labels %v, got %v", expect, labels)
+	}
+
+	// A label added in the Console is drift, unlike the default labels.
+	api.labelCluster(id, map[string]string{"team": "platform", "cost-center": "7", "env": "dev", "owner": "jane"})
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	state.Get(ctx, &data)
+	if expect := labelsValue(map[string]string{"cost-center": "7", "env": "dev", "owner": "jane"}); !data.Labels.Equal(expect) {
+		t.Errorf("expected labels %v, got %v", expect, data.Labels)
+	}
+	if len(data.AllLabels.Elements()) != 4 {
+		t.Errorf("expected all the labels of the cluster, got %v", data.AllLabels)
+	}
+
+	update := clusterPlan(t, map[string]string{"env": "prod"})
+	update.Id = data.Id
+	update.Stage = types.StringValue("prod")
+
+	if _, diags := testUpdate(t, r, state, update); diags.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", diags)
+	}
+
+	expect = map[string]string{"team": "platform", "cost-center": "42", "env": "prod"}
+	if labels := api.clusterLabels(id); !maps.Equal(labels, expect) {
+		t.Errorf("expected the cluster labels to be updated to %v, got %v", expect, labels)
+	}
+}
+
+// clusterPlan plans a trial cluster of the fake Console API with labels.
+func clusterPlan(t *testing.T, labels map[string]string) camundaClusterData {
+	t.Helper()
+
+	return camundaClusterData{
+		Id:                    types.StringUnknown(),
+		Name:                  types.StringValue("test"),
+		Channel:               types.StringValue(fakeChannelStableID),
+		Region:                types.StringValue(fakeRegionBelgiumID),
+		PlanType:              types.StringValue(fakePlanTypeTrialID),
+		Generation:            types.StringValue(fakeGeneration86ID),
+		Stage:                 types.StringUnknown(),
+		Labels:                labelsValue(labels),
+		AllLabels:             types.MapUnknown(types.StringType),
+		DeletionProtection:    types.BoolValue(false),
+		WaitForHealthy:        types.BoolValue(true),
+		WaitForComponents:     types.SetValueMust(types.StringType, []attr.Value{}),
+		DeleteOnCreateFailure: types.BoolValue(false),
+	}
+}
+
+func labelsValue(labels map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for key, value := range labels {
+		elements[key] = types.StringValue(value)
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func TestAccCamundaClusterResourceDeletionProtection(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: api.providerConfig() + testAccProtectedClusterConfig(true),
+				Check:  resource.TestCheckResourceAttr("camunda_cluster.test", "deletion_protection", "true"),
+			},
+			{
+				Config:      api.providerConfig() + testAccProtectedClusterConfig(true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Cluster is protected from deletion"),
+			},
+			// Protection must be lifted in a separate apply before destroying.
+			{
+				Config: api.providerConfig() + testAccProtectedClusterConfig(false),
+				Check:  resource.TestCheckResourceAttr("camunda_cluster.test", "deletion_protection", "false"),
+			},
+		},
+	})
+}
+
+func testAccProtectedClusterConfig(protected bool) string {
+	return fmt.Sprintf(`
+resource "camunda_cluster" "test" {
+  name       = "tf-acc-protected"
+  channel    = %[1]q
+  generation = %[2]q
+  region     = %[3]q
+  plan_type  = %[4]q
+
+  deletion_protection = %[5]t
+}
+`, fakeChannelStableID, fakeGeneration86ID, fakeRegionBelgiumID, fakePlanTypeTrialID, protected)
+}
+
+func TestCamundaClusterResourceDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	r := &CamundaClusterResource{provider: api.provider()}
+
+	plan := clusterPlan(t, nil)
+	plan.DeletionProtection = types.BoolValue(true)
+
+	state, diags := testCreate(t, r, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	if diags := testDelete(t, r, state); !diags.HasError() {
+		t.Errorf("expected the deletion of a protected cluster to fail")
+	}
+	if count := api.clusterCount(); count != 1 {
+		t.Fatalf("expected the protected cluster to be kept, %d clusters left", count)
+	}
+
+	var data camundaClusterData
+	state.Get(ctx, &data)
+
+	update := clusterPlan(t, nil)
+	update.Id = data.Id
+	update.DeletionProtection = types.BoolValue(false)
+
+	state, diags = testUpdate(t, r, state, update)
+	if diags.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", diags)
+	}
+
+	if diags := testDelete(t, r, state); diags.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", diags)
+	}
+	if count := api.clusterCount(); count != 0 {
+		t.Errorf("expected the cluster to be deleted, %d clusters left", count)
+	}
+}
+
+func TestCamundaClusterResourceWaitForComponents(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+	api.stalledComponents = []string{clusterComponentOperate}
+	api.unreportedComponents = []string{clusterComponentOptimize}
+	r := &CamundaClusterResource{provider: api.provider()}
+
+	timeout := clusterCreateTimeout
+	clusterCreateTimeout = time.Second
+	t.Cleanup(func() { clusterCreateTimeout = timeout })
+
+	for name, testCase := range map[string]struct {
+		waitForHealthy bool
+		components     []string
+		error          string
+	}{
+		"overall status": {
+			waitForHealthy: true,
+		},
+		"healthy component": {
+			waitForHealthy: true,
+			components:     []string{clusterComponentZeebe, clusterComponentConnectors},
+		},
+		"stalled component": {
+			waitForHealthy: true,
+			components:     []string{clusterComponentOperate},
+			error:          "operate: Creating",
+		},
+		"unreported component": {
+			waitForHealthy: true,
+			components:     []string{clusterComponentOptimize},
+			error:          "component optimize is not reported",
+		},
+		"no wait": {
+			waitForHealthy: false,
+			components:     []string{clusterComponentOperate},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			plan := clusterPlan(t, nil)
+			plan.WaitForHealthy = types.BoolValue(testCase.waitForHealthy)
+			plan.WaitForComponents = setValue(testCase.components)
+
+			_, diags := testCreate(t, r, plan)
+
+			if testCase.error == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected create diagnostics: %v", diags)
+				}
+				return
+			}
+
+			if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), testCase.error) {
+				t.Errorf("expected the create error to report %q, got %v", testCase.error, diags)
+			}
+		})
+	}
+}
+
+func setValue(values []string) types.Set {
+	elements := []attr.Value{}
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func TestCamundaClusterResourceDeleteOnCreateFailure(t *testing.T) {
+	timeout := clusterCreateTimeout
+	clusterCreateTimeout = time.Second
+	t.Cleanup(func() { clusterCreateTimeout = timeout })
+
+	for name, deleteOnCreateFailure := range map[string]bool{"kept": false, "deleted": true} {
+		t.Run(name, func(t *testing.T) {
+			api := newFakeConsoleAPI(t)
+			api.stalledComponents = []string{clusterComponentOperate}
+			r := &CamundaClusterResource{provider: api.provider()}
+
+			plan := clusterPlan(t, nil)
+			plan.WaitForComponents = setValue([]string{clusterComponentOperate})
+			plan.DeleteOnCreateFailure = types.BoolValue(deleteOnCreateFailure)
+
+			state, diags := testCreate(t, r, plan)
+			if !diags.HasError() {
+				t.Fatalf("expected the creation of a stalled cluster to fail")
+			}
+
+			if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "Last observed status: ready: Healthy") {
+				t.Errorf("expected the create error to report the cluster status, got %q", detail)
+			}
+
+			expect := 1
+			if deleteOnCreateFailure {
+				expect = 0
+			}
+
+			if count := api.clusterCount(); count != expect {
+				t.Errorf("expected %d clusters left, got %d", expect, count)
+			}
+			if state.Raw.IsNull() != deleteOnCreateFailure {
+				t.Errorf("expected the cluster to be removed from the state only when deleted")
+			}
+		})
+	}
+}
+
+func TestCamundaClusterResourceReadUnhealthy(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+	r := &CamundaClusterResource{provider: api.provider()}
+
+	state, diags := testCreate(t, r, clusterPlan(t, nil))
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	var data camundaClusterData
+	state.Get(context.Background(), &data)
+
+	if _, diags := testRead(t, r, state); diags.WarningsCount() != 0 {
+		t.Errorf("unexpected read warnings for a healthy cluster: %v", diags)
+	}
+
+	api.hibernateCluster(data.Id.ValueString())
+
+	_, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "ready: Unhealthy") {
+		t.Errorf("expected a warning about the unhealthy cluster, got %v", diags)
+	}
+}
+
+func TestCamundaClusterResourceReadTransportError(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+	r := &CamundaClusterResource{provider: api.provider()}
+
+	state, diags := testCreate(t, r, clusterPlan(t, nil))
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	// Requests fail without any response once the API is unreachable.
+	api.server.Close()
+
+	if _, diags := testRead(t, r, state); !diags.HasError() {
+		t.Errorf("expected reading an unreachable cluster to fail")
+	}
+}
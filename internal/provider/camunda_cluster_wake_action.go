@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/camunda-community-hub/terraform-provider-camunda/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ action.Action = &CamundaClusterWakeAction{}
+var _ action.ActionWithConfigure = &CamundaClusterWakeAction{}
+
+type camundaClusterWakeData struct {
+	ClusterId types.String `tfsdk:"cluster_id"`
+	Timeout   types.String `tfsdk:"timeout"`
+}
+
+type CamundaClusterWakeAction struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaClusterWakeAction() action.Action {
+	return &CamundaClusterWakeAction{}
+}
+
+func (a *CamundaClusterWakeAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_wake"
+}
+
+func (a *CamundaClusterWakeAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resume a hibernated trial cluster and wait until it is healthy again.",
+
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "Cluster ID",
+				Required:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("How long to wait for the cluster to be healthy, as a duration such as `30m` or `2h`. Defaults to `%s`.", defaultActionTimeout),
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsDuration{},
+				},
+			},
+		},
+	}
+}
+
+func (a *CamundaClusterWakeAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	a.provider = configureAction(req, resp)
+}
+
+func (a *CamundaClusterWakeAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data camundaClusterWakeData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, a.provider.accessToken)
+	clusterId := data.ClusterId.ValueString()
+
+	_, err := a.provider.client.DefaultAPI.WakeCluster(ctx, clusterId).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to wake cluster ID=%s, got error: %s", clusterId, formatClientError(err)),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "Camunda cluster woken up", map[string]interface{}{
+		"clusterID": clusterId,
+	})
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for cluster %s to be healthy", clusterId),
+	})
+
+	// A hibernated cluster reports unhealthy components until it is resumed.
+	_, err = waitForClusterHealthy(ctx, a.provider, clusterId, nil, []console.ClusterComponentStatus{
+		console.CLUSTERCOMPONENTSTATUS_UNHEALTHY,
+		console.CLUSTERCOMPONENTSTATUS_CREATING,
+		console.CLUSTERCOMPONENTSTATUS_UPDATING,
+	}, actionTimeout(data.Timeout))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to wake cluster",
+			fmt.Sprintf("Cluster %s never got healthy; got error: %s", clusterId, err),
+		)
+		return
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCamundaClusterWakeAction(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+	api.addCluster("cluster-1")
+	api.hibernateCluster("cluster-1")
+
+	_, diags := testInvoke(t, &CamundaClusterWakeAction{provider: api.provider()}, camundaClusterWakeData{
+		ClusterId: types.StringValue("cluster-1"),
+		Timeout:   types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected invoke diagnostics: %v", diags)
+	}
+
+	if status := api.clusters["cluster-1"].Status.Ready; status != console.CLUSTERCOMPONENTSTATUS_HEALTHY {
+		t.Errorf("expected the cluster to be healthy, got %s", status)
+	}
+}
+
+func TestCamundaClusterWakeActionUnknownCluster(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	_, diags := testInvoke(t, &CamundaClusterWakeAction{provider: api.provider()}, camundaClusterWakeData{
+		ClusterId: types.StringValue("cluster-1"),
+		Timeout:   types.StringNull(),
+	})
+	if !diags.HasError() {
+		t.Errorf("expected an error waking up an unknown cluster")
+	}
+}
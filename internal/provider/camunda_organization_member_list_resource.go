@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResource = &CamundaOrganizationMemberListResource{}
+var _ list.ListResourceWithConfigure = &CamundaOrganizationMemberListResource{}
+
+type CamundaOrganizationMemberListResource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaOrganizationMemberListResource() list.ListResource {
+	return &CamundaOrganizationMemberListResource{}
+}
+
+func (r *CamundaOrganizationMemberListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member"
+}
+
+func (r *CamundaOrganizationMemberListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the members of the organization. Pending invitations are not listed.",
+	}
+}
+
+func (r *CamundaOrganizationMemberListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider = configureListResource(req, resp)
+}
+
+func (r *CamundaOrganizationMemberListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+
+	members, _, err := r.provider.client.DefaultAPI.GetMembers(ctx).Execute()
+	if err != nil {
+		stream.Results = listError("Client Error", fmt.Sprintf("Unable to get organization members, got error: %s", formatClientError(err)))
+		return
+	}
+
+	stream.Results = listResults(ctx, req, members, func(member console.Member, result *list.ListResult) {
+		result.DisplayName = member.Email
+		if member.Name != "" {
+			result.DisplayName = fmt.Sprintf("%s <%s>", member.Name, member.Email)
+		}
+
+		result.Diagnostics.Append(result.Identity.Set(ctx, camundaOrganizationMemberIdentity{Email: types.StringValue(member.Email)})...)
+
+		if !req.IncludeResource {
+			return
+		}
+
+		roles, diags := types.SetValueFrom(ctx, types.StringType, assignableMemberRoles(member))
+		result.Diagnostics.Append(diags...)
+
+		data := camundaOrganizationMemberData{
+			Email:                 types.StringValue(member.Email),
+			Roles:                 roles,
+			Status:                types.StringValue(memberStatusActive),
+			WaitForAcceptance:     types.BoolValue(false),
+			AcceptanceTimeout:     types.StringValue("30m"),
+			AllowLastAdminRemoval: types.BoolValue(false),
+		}
+
+		result.Diagnostics.Append(result.Resource.Set(ctx, &data)...)
+	})
+}
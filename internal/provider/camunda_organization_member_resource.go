@@ -3,26 +3,51 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/camunda-community-hub/terraform-provider-camunda/internal/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
 var _ resource.Resource = &CamundaOrganizationMemberResource{}
 var _ resource.ResourceWithImportState = &CamundaOrganizationMemberResource{}
+var _ resource.ResourceWithModifyPlan = &CamundaOrganizationMemberResource{}
+var _ resource.ResourceWithIdentity = &CamundaOrganizationMemberResource{}
+var _ resource.ResourceWithUpgradeState = &CamundaOrganizationMemberResource{}
+
+// How long to wait before first checking whether an invitation was accepted,
+// and the minimum interval between checks.
+var (
+	memberStatusDelay        = 10 * time.Second
+	memberStatusPollInterval = 10 * time.Second
+)
 
 type camundaOrganizationMemberData struct {
+	Email             types.String `tfsdk:"email"`
+	Roles             types.Set    `tfsdk:"roles"`
+	Status            types.String `tfsdk:"status"`
+	WaitForAcceptance types.Bool   `tfsdk:"wait_for_acceptance"`
+	AcceptanceTimeout types.String `tfsdk:"acceptance_timeout"`
+
+	AllowLastAdminRemoval types.Bool `tfsdk:"allow_last_admin_removal"`
+}
+
+type camundaOrganizationMemberIdentity struct {
 	Email types.String `tfsdk:"email"`
-	Roles types.Set    `tfsdk:"roles"`
 }
 
 type CamundaOrganizationMemberResource struct {
@@ -40,6 +65,7 @@ func (r *CamundaOrganizationMemberResource) Metadata(ctx context.Context, req re
 func (r *CamundaOrganizationMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manage a member of an organization",
+		Version:             1,
 
 		Attributes: map[string]schema.Attribute{
 			"email": schema.StringAttribute{
@@ -53,17 +79,47 @@ func (r *CamundaOrganizationMemberResource) Schema(ctx context.Context, req reso
 				ElementType:         types.StringType,
 				Validators: []validator.Set{
 					setvalidator.ValueStringsAre(
-						stringvalidator.OneOf([]string{
-							string(console.ORGANIZATIONROLEADMIN_ADMIN),
-							string(console.ORGANIZATIONROLEOPERATIONSENGINEER_OPERATIONSENGINEER),
-							string(console.ORGANIZATIONROLETASKUSER_TASKUSER),
-							string(console.ORGANIZATIONROLEANALYST_ANALYST),
-							string(console.ORGANIZATIONROLEDEVELOPER_DEVELOPER),
-							string(console.ORGANIZATIONROLEVISITOR_VISITOR),
-						}...),
+						stringvalidator.OneOf(assignableOrganizationRoles...),
 					),
 				},
 			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the membership: `invited` while the invitation has not been accepted yet, `active` once the member joined the organization.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"wait_for_acceptance": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait, on creation, until the invited member accepted the invitation. If the invitation is not accepted within `acceptance_timeout`, the apply completes with a warning and the member stays `invited`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"acceptance_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for the invitation to be accepted when `wait_for_acceptance` is set, as a duration such as `30m` or `2h`. Defaults to `30m`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("30m"),
+				Validators: []validator.String{
+					validators.IsDuration{},
+				},
+			},
+			"allow_last_admin_removal": schema.BoolAttribute{
+				MarkdownDescription: "Allow removing this member, or its `admin` role, even if it is the organization owner or the last admin of the organization. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *CamundaOrganizationMemberResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"email": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Email address of the member",
+			},
 		},
 	}
 }
@@ -98,8 +154,16 @@ func (r *CamundaOrganizationMemberResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	var roles []string
+	diags = data.Roles.ElementsAs(ctx, &roles, false)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
-	err := setMember(ctx, *r.provider.client, data.Email, data.Roles)
+	err := setMember(ctx, *r.provider.client, data.Email.ValueString(), roles)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -109,13 +173,69 @@ func (r *CamundaOrganizationMemberResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	status, err := memberStatus(ctx, *r.provider.client, data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get organization members, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	data.Status = types.StringValue(status)
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 
+	diags = resp.Identity.Set(ctx, camundaOrganizationMemberIdentity{Email: data.Email})
+	resp.Diagnostics.Append(diags...)
+
 	tflog.Info(ctx, "Member added to organization", map[string]interface{}{
-		"email": data.Email,
-		"roles": data.Roles,
+		"email":  data.Email,
+		"roles":  data.Roles,
+		"status": status,
 	})
+
+	if status == memberStatusActive || !data.WaitForAcceptance.ValueBool() {
+		return
+	}
+
+	// The state is already saved with an `invited` status. Errors would taint the
+	// member and invite it again on the next apply, so a timeout is only a warning.
+	timeout, _ := time.ParseDuration(data.AcceptanceTimeout.ValueString())
+
+	acceptState := &retry.StateChangeConf{
+		Pending: []string{memberStatusInvited},
+		Target:  []string{memberStatusActive},
+
+		Refresh: func() (interface{}, string, error) {
+			status, err := memberStatus(ctx, *r.provider.client, data.Email.ValueString())
+			if err != nil {
+				return nil, "", err
+			}
+
+			return status, status, nil
+		},
+
+		Timeout:    timeout,
+		Delay:      memberStatusDelay,
+		MinTimeout: memberStatusPollInterval,
+	}
+
+	_, err = acceptState.WaitForStateContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Invitation not accepted",
+			fmt.Sprintf("Member '%s' did not accept the invitation within %s and stays invited; got error: %s",
+				data.Email.ValueString(), data.AcceptanceTimeout.ValueString(), err),
+		)
+		return
+	}
+
+	data.Status = types.StringValue(memberStatusActive)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *CamundaOrganizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -139,24 +259,42 @@ func (r *CamundaOrganizationMemberResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	searchFor := data.Email.ValueString()
+	// The email stays as configured: the API may return it with a different case.
+	if member := findMember(members, data.Email.ValueString()); member != nil {
+		roles, diags := types.SetValueFrom(ctx, types.StringType, assignableMemberRoles(*member))
+		resp.Diagnostics.Append(diags...)
 
-	for _, member := range members {
-		if member.Email == searchFor {
-			roles, diags := types.SetValueFrom(ctx, types.StringType, member.Roles)
-			resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-			if resp.Diagnostics.HasError() {
-				return
-			}
+		data.Roles = roles
+		data.Status = types.StringValue(memberStatusActive)
 
-			data.Email = types.StringValue(member.Email)
-			data.Roles = roles
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
 
-			diags = resp.State.Set(ctx, &data)
-			resp.Diagnostics.Append(diags...)
-			return
-		}
+		diags = resp.Identity.Set(ctx, camundaOrganizationMemberIdentity{Email: data.Email})
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// The API does not list pending invitations and invited members are only
+	// listed once they accepted, so keep them in the state until then instead
+	// of inviting them again. Any other missing member was removed.
+	if data.Status.ValueString() == memberStatusInvited {
+		tflog.Info(ctx, "Member invitation still pending", map[string]interface{}{
+			"email": data.Email,
+		})
+
+		data.Status = types.StringValue(memberStatusInvited)
+
+		diags = resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+
+		diags = resp.Identity.Set(ctx, camundaOrganizationMemberIdentity{Email: data.Email})
+		resp.Diagnostics.Append(diags...)
+		return
 	}
 
 	tflog.Info(ctx, "Member not found", map[string]interface{}{
@@ -176,8 +314,16 @@ func (r *CamundaOrganizationMemberResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	var roles []string
+	diags = data.Roles.ElementsAs(ctx, &roles, false)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
-	err := setMember(ctx, *r.provider.client, data.Email, data.Roles)
+	err := setMember(ctx, *r.provider.client, data.Email.ValueString(), roles)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -187,6 +333,17 @@ func (r *CamundaOrganizationMemberResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	status, err := memberStatus(ctx, *r.provider.client, data.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get organization members, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	data.Status = types.StringValue(status)
+
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 }
@@ -214,38 +371,125 @@ func (r *CamundaOrganizationMemberResource) Delete(ctx context.Context, req reso
 	}
 }
 
-func (r *CamundaOrganizationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("email"), req, resp)
-}
+func (r *CamundaOrganizationMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to protect while creating the member, or before the provider is configured.
+	if req.State.Raw.IsNull() || r.provider == nil {
+		return
+	}
+
+	var state camundaOrganizationMemberData
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	destroy := req.Plan.Raw.IsNull()
+	replace := false
+	allowRemoval := state.AllowLastAdminRemoval
 
+	if !destroy {
+		var plan camundaOrganizationMemberData
 
-func setMember(ctx context.Context, client console.APIClient, email types.String, roles types.Set) error {
-	orgRoles := make([]console.AssignableOrganizationRoleType, 0)
+		diags = req.Plan.Get(ctx, &plan)
+		resp.Diagnostics.Append(diags...)
 
-	for _, r := range roles.Elements() {
-		var role console.AssignableOrganizationRoleType
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-		roleName := r.String()
-		err := role.UnmarshalJSON([]byte(roleName))
+		// Changing the email replaces the member, which removes the current one.
+		replace = !plan.Email.Equal(state.Email)
 
-		if err != nil {
-			return fmt.Errorf("unable to read role: %w", err)
+		// Otherwise, only the removal of the admin role needs to be checked on updates.
+		if !replace && (plan.Roles.IsUnknown() || !setHasRole(state.Roles, organizationRoleAdmin) || setHasRole(plan.Roles, organizationRoleAdmin)) {
+			return
 		}
 
-		orgRoles = append(orgRoles, role)
+		allowRemoval = plan.AllowLastAdminRemoval
 	}
 
-	body := console.PostMemberBody{
-		OrgRoles: orgRoles,
+	if allowRemoval.ValueBool() {
+		return
 	}
 
-	_, err := client.DefaultAPI.UpdateMembers(ctx, email.ValueString()).
-		PostMemberBody(body).
-		Execute()
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+	members, _, err := r.provider.client.DefaultAPI.GetMembers(ctx).Execute()
 
 	if err != nil {
-		return fmt.Errorf("error while calling the update member API: %w", err)
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get organization members, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	email := state.Email.ValueString()
+	reason := lastAdminRemovalReason(members, email)
+	if reason == "" {
+		return
+	}
+
+	if destroy {
+		resp.Diagnostics.AddError(
+			"Refusing to remove organization member",
+			fmt.Sprintf("Member '%s' %s. Set `allow_last_admin_removal = true` and apply before removing it.", email, reason),
+		)
+		return
+	}
+
+	if replace {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Refusing to replace organization member",
+			fmt.Sprintf("Member '%s' %s, and changing its email removes it. Set `allow_last_admin_removal = true` to replace it.", email, reason),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root("roles"),
+		"Refusing to remove the admin role",
+		fmt.Sprintf("Member '%s' %s. Set `allow_last_admin_removal = true` to remove its `admin` role.", email, reason),
+	)
+}
+
+func (r *CamundaOrganizationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("email"), path.Root("email"), req, resp)
+}
+
+func (r *CamundaOrganizationMemberResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: stateUpgrader(camundaOrganizationMemberSchemaV0, upgradeCamundaOrganizationMemberStateV0),
 	}
+}
+
+// camundaOrganizationMemberDataV0 is the state of organization members before
+// schemas were versioned.
+type camundaOrganizationMemberDataV0 struct {
+	Email types.String `tfsdk:"email"`
+	Roles types.Set    `tfsdk:"roles"`
+}
+
+var camundaOrganizationMemberSchemaV0 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"email": schema.StringAttribute{Required: true},
+		"roles": schema.SetAttribute{ElementType: types.StringType, Required: true},
+	},
+}
 
-	return nil
+// upgradeCamundaOrganizationMemberStateV0 sets the defaults of the attributes
+// that older versions of the provider did not have. The status is left unset
+// until the member is read again.
+func upgradeCamundaOrganizationMemberStateV0(prior camundaOrganizationMemberDataV0) camundaOrganizationMemberData {
+	return camundaOrganizationMemberData{
+		Email:                 prior.Email,
+		Roles:                 prior.Roles,
+		Status:                types.StringNull(),
+		WaitForAcceptance:     types.BoolValue(false),
+		AcceptanceTimeout:     types.StringValue("30m"),
+		AllowLastAdminRemoval: types.BoolValue(false),
+	}
 }
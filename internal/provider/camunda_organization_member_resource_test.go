@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCamundaOrganizationMemberResource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			if emails := api.memberEmails(); len(emails) != 1 || emails[0] != fakeOwnerEmail {
+				return fmt.Errorf("expected only the owner to be left, got %v", emails)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: api.providerConfig() + testAccCamundaOrganizationMemberResourceConfig(`"developer"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("camunda_organization_member.test", "email", "tf-acc-member@example.org"),
+					resource.TestCheckResourceAttr("camunda_organization_member.test", "roles.#", "1"),
+					resource.TestCheckResourceAttr("camunda_organization_member.test", "status", memberStatusActive),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "camunda_organization_member.test",
+				ImportState:             true,
+				ImportStateId:           "tf-acc-member@example.org",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_acceptance", "acceptance_timeout", "allow_last_admin_removal"},
+			},
+			// Update and Read testing
+			{
+				Config: api.providerConfig() + testAccCamundaOrganizationMemberResourceConfig(`"analyst", "developer"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("camunda_organization_member.test", "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("camunda_organization_member.test", "roles.*", "analyst"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCamundaOrganizationMemberResourceConfig(roles string) string {
+	return fmt.Sprintf(`
+resource "camunda_organization_member" "test" {
+  email = "tf-acc-member@example.org"
+  roles = [%s]
+}
+`, roles)
+}
+
+func TestCamundaOrganizationMemberResourceReadMissing(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		status types.String
+		kept   bool
+	}{
+		"invited member is kept":    {status: types.StringValue(memberStatusInvited), kept: true},
+		"active member is removed":  {status: types.StringValue(memberStatusActive)},
+		"unknown status is removed": {status: types.StringNull()},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			api := newFakeConsoleAPI(t)
+			r := &CamundaOrganizationMemberResource{provider: api.provider()}
+
+			state := stateFrom(t, testResourceSchema(t, r), camundaOrganizationMemberData{
+				Email:                 types.StringValue("jane@example.org"),
+				Roles:                 types.SetValueMust(types.StringType, []attr.Value{types.StringValue("developer")}),
+				Status:                testCase.status,
+				WaitForAcceptance:     types.BoolValue(false),
+				AcceptanceTimeout:     types.StringValue("30m"),
+				AllowLastAdminRemoval: types.BoolValue(false),
+			})
+
+			state, diags := testRead(t, r, state)
+			if diags.HasError() {
+				t.Fatalf("unexpected read diagnostics: %v", diags)
+			}
+
+			if kept := !state.Raw.IsNull(); kept != testCase.kept {
+				t.Errorf("expected the member to be kept: %t, got %t", testCase.kept, kept)
+			}
+		})
+	}
+}
+
+func TestCamundaOrganizationMemberResourceAcceptanceTimeout(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	api.pendingInvites = true
+
+	delay, interval := memberStatusDelay, memberStatusPollInterval
+	memberStatusDelay, memberStatusPollInterval = 0, 10*time.Millisecond
+	t.Cleanup(func() { memberStatusDelay, memberStatusPollInterval = delay, interval })
+
+	r := &CamundaOrganizationMemberResource{provider: api.provider()}
+
+	state, diags := testCreate(t, r, camundaOrganizationMemberData{
+		Email:                 types.StringValue("jane@example.org"),
+		Roles:                 types.SetValueMust(types.StringType, []attr.Value{types.StringValue("developer")}),
+		Status:                types.StringUnknown(),
+		WaitForAcceptance:     types.BoolValue(true),
+		AcceptanceTimeout:     types.StringValue("50ms"),
+		AllowLastAdminRemoval: types.BoolValue(false),
+	})
+
+	// An error would taint the member and invite it again on the next apply.
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Errorf("expected a warning about the pending invitation, got %v", diags)
+	}
+
+	var data camundaOrganizationMemberData
+	state.Get(ctx, &data)
+
+	if data.Status.ValueString() != memberStatusInvited {
+		t.Errorf("expected the member to stay invited, got %s", data.Status)
+	}
+}
+
+func TestCamundaOrganizationMemberResourceModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	member := func(email string, allowLastAdminRemoval bool, roles ...string) camundaOrganizationMemberData {
+		values := []attr.Value{}
+		for _, role := range roles {
+			values = append(values, types.StringValue(role))
+		}
+
+		return camundaOrganizationMemberData{
+			Email:                 types.StringValue(email),
+			Roles:                 types.SetValueMust(types.StringType, values),
+			Status:                types.StringValue(memberStatusActive),
+			WaitForAcceptance:     types.BoolValue(false),
+			AcceptanceTimeout:     types.StringValue("30m"),
+			AllowLastAdminRemoval: types.BoolValue(allowLastAdminRemoval),
+		}
+	}
+
+	testCases := map[string]struct {
+		plan  camundaOrganizationMemberData
+		error string
+	}{
+		"unchanged": {
+			plan: member(fakeOwnerEmail, false, "admin"),
+		},
+		"admin role removed": {
+			plan:  member(fakeOwnerEmail, false, "developer"),
+			error: "Refusing to remove the admin role",
+		},
+		"email changed": {
+			plan:  member("jane@example.org", false, "admin"),
+			error: "Refusing to replace organization member",
+		},
+		"email changed with removal allowed": {
+			plan: member("jane@example.org", true, "admin"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			api := newFakeConsoleAPI(t)
+			r := &CamundaOrganizationMemberResource{provider: api.provider()}
+			state := stateFrom(t, testResourceSchema(t, r), member(fakeOwnerEmail, false, "admin"))
+
+			diags := testModifyPlan(t, r, state, testCase.plan)
+
+			if testCase.error == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected plan diagnostics: %v", diags)
+				}
+				return
+			}
+
+			if !diags.HasError() || diags.Errors()[0].Summary() != testCase.error {
+				t.Errorf("expected the plan to fail with %q, got %v", testCase.error, diags)
+			}
+		})
+	}
+}
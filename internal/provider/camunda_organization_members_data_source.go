@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CamundaOrganizationMembersDataSource{}
+
+type organizationMembersDataSourceData struct {
+	Id           types.String                    `tfsdk:"id"`
+	Roles        []types.String                  `tfsdk:"roles"`
+	EmailDomains []types.String                  `tfsdk:"email_domains"`
+	Members      []organizationMemberDetailModel `tfsdk:"members"`
+	Emails       []types.String                  `tfsdk:"emails"`
+}
+
+type organizationMemberDetailModel struct {
+	Email  types.String   `tfsdk:"email"`
+	Name   types.String   `tfsdk:"name"`
+	Roles  []types.String `tfsdk:"roles"`
+	Status types.String   `tfsdk:"status"`
+}
+
+type CamundaOrganizationMembersDataSource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaOrganizationMembersDataSource() datasource.DataSource {
+	return &CamundaOrganizationMembersDataSource{}
+}
+
+func (d *CamundaOrganizationMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_members"
+}
+
+func (d *CamundaOrganizationMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the members of the organization and their roles",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID",
+				Computed:            true,
+			},
+			"roles": schema.SetAttribute{
+				MarkdownDescription: "Only return the members holding at least one of these roles, such as `admin` or `owner`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(append([]string{organizationRoleOwner}, assignableOrganizationRoles...)...),
+					),
+				},
+			},
+			"email_domains": schema.SetAttribute{
+				MarkdownDescription: "Only return the members whose email belongs to one of these domains, such as `example.org`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"members": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email of the member",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the member",
+							Computed:            true,
+						},
+						"roles": schema.ListAttribute{
+							MarkdownDescription: "The roles of the member in the organization",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the membership. Always `active`, as the Console API only lists members who accepted their invitation.",
+							Computed:            true,
+						},
+					},
+				},
+				MarkdownDescription: "The members matching the filters, sorted by email",
+				Computed:            true,
+			},
+			"emails": schema.ListAttribute{
+				MarkdownDescription: "The emails of the members matching the filters, sorted",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *CamundaOrganizationMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *CamundaOrganizationMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data organizationMembersDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, d.provider.accessToken)
+	members, _, err := d.provider.client.DefaultAPI.GetMembers(ctx).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get organization members, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	var roles, domains []string
+	for _, role := range data.Roles {
+		roles = append(roles, role.ValueString())
+	}
+	for _, domain := range data.EmailDomains {
+		domains = append(domains, domain.ValueString())
+	}
+
+	members = filterOrganizationMembers(members, roles, domains)
+
+	data.Id = types.StringValue(organizationMembersID)
+	data.Members = []organizationMemberDetailModel{}
+	data.Emails = []types.String{}
+
+	for _, member := range members {
+		memberRoleValues := []types.String{}
+		for _, role := range sortedRoles(memberRoles(member)) {
+			memberRoleValues = append(memberRoleValues, types.StringValue(role))
+		}
+
+		data.Members = append(data.Members, organizationMemberDetailModel{
+			Email:  types.StringValue(member.Email),
+			Name:   types.StringValue(member.Name),
+			Roles:  memberRoleValues,
+			Status: types.StringValue(memberStatusActive),
+		})
+		data.Emails = append(data.Emails, types.StringValue(member.Email))
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+// filterOrganizationMembers keeps the members holding one of the given roles
+// and whose email belongs to one of the given domains, sorted by email. An
+// empty filter matches every member.
+func filterOrganizationMembers(members []console.Member, roles []string, domains []string) []console.Member {
+	filtered := []console.Member{}
+
+	for _, member := range members {
+		if len(roles) > 0 {
+			matches := false
+			for _, role := range roles {
+				if memberHasRole(member, role) {
+					matches = true
+					break
+				}
+			}
+
+			if !matches {
+				continue
+			}
+		}
+
+		if len(domains) > 0 {
+			at := strings.LastIndex(member.Email, "@")
+			if at < 0 || !containsFold(domains, member.Email[at+1:]) {
+				continue
+			}
+		}
+
+		filtered = append(filtered, member)
+	}
+
+	sort.Slice(filtered, func(i, j int) bool {
+		return strings.ToLower(filtered[i].Email) < strings.ToLower(filtered[j].Email)
+	})
+
+	return filtered
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+)
+
+func TestFilterOrganizationMembers(t *testing.T) {
+	t.Parallel()
+
+	members := []console.Member{
+		{Email: "zoe@partner.com", Roles: []console.OrganizationRoleType{"admin"}},
+		{Email: "jane@Example.org", Roles: []console.OrganizationRoleType{"owner"}},
+		{Email: "john@example.org", Roles: []console.OrganizationRoleType{"developer"}},
+		{Email: "amy@example.org", Roles: []console.OrganizationRoleType{"admin", "developer"}},
+	}
+
+	testCases := map[string]struct {
+		roles   []string
+		domains []string
+		expect  []string
+	}{
+		"no filter": {
+			expect: []string{"amy@example.org", "jane@Example.org", "john@example.org", "zoe@partner.com"},
+		},
+		"by role": {
+			roles:  []string{"admin", "owner"},
+			expect: []string{"amy@example.org", "jane@Example.org", "zoe@partner.com"},
+		},
+		"by domain": {
+			domains: []string{"EXAMPLE.org"},
+			expect:  []string{"amy@example.org", "jane@Example.org", "john@example.org"},
+		},
+		"by role and domain": {
+			roles:   []string{"admin"},
+			domains: []string{"partner.com"},
+			expect:  []string{"zoe@partner.com"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filtered := filterOrganizationMembers(members, testCase.roles, testCase.domains)
+
+			emails := []string{}
+			for _, member := range filtered {
+				emails = append(emails, member.Email)
+			}
+
+			if len(emails) != len(testCase.expect) {
+				t.Fatalf("expected %v, got %v", testCase.expect, emails)
+			}
+
+			for i := range emails {
+				if emails[i] != testCase.expect[i] {
+					t.Fatalf("expected %v, got %v", testCase.expect, emails)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,660 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CamundaOrganizationMembersResource{}
+var _ resource.ResourceWithImportState = &CamundaOrganizationMembersResource{}
+var _ resource.ResourceWithModifyPlan = &CamundaOrganizationMembersResource{}
+
+// The organization is implied by the provider credentials, so there is a
+// single instance of this resource per organization.
+const organizationMembersID = "organization"
+
+// organizationMembersManagedKey is the private state key holding the emails of
+// the configured members: the refreshed state also holds members added
+// outside of Terraform, which must not be removed on destroy.
+const organizationMembersManagedKey = "managed_emails"
+
+type camundaOrganizationMembersData struct {
+	Id                 types.String `tfsdk:"id"`
+	Members            types.Map    `tfsdk:"members"`
+	IgnoreEmails       types.Set    `tfsdk:"ignore_emails"`
+	PendingInvitations types.Set    `tfsdk:"pending_invitations"`
+}
+
+type CamundaOrganizationMembersResource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaOrganizationMembersResource() resource.Resource {
+	return &CamundaOrganizationMembersResource{}
+}
+
+func (r *CamundaOrganizationMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_members"
+}
+
+func (r *CamundaOrganizationMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Authoritatively manage all the members of an organization. Members that are not configured are removed from the organization. Destroying the resource only removes the configured members that are not ignored.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"members": schema.MapAttribute{
+				MarkdownDescription: "The members of the organization, as a map of their email to their roles. Roles must be one of: `admin`, `analyst`, `developer`, `operationsengineer`, `taskuser`, or `visitor`.",
+				Required:            true,
+				ElementType:         types.SetType{ElemType: types.StringType},
+				Validators: []validator.Map{
+					mapvalidator.ValueSetsAre(
+						setvalidator.SizeAtLeast(1),
+						setvalidator.ValueStringsAre(
+							stringvalidator.OneOf(assignableOrganizationRoles...),
+						),
+					),
+				},
+			},
+			"ignore_emails": schema.SetAttribute{
+				MarkdownDescription: "Emails of members that are not managed by this resource and must never be removed, such as break-glass accounts. The organization owner is always ignored unless configured in `members`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"pending_invitations": schema.SetAttribute{
+				MarkdownDescription: "Emails of the configured members that did not accept their invitation yet.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *CamundaOrganizationMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.provider = provider
+}
+
+func (r *CamundaOrganizationMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data camundaOrganizationMembersData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := organizationMembersFromMap(ctx, data.Members)
+	resp.Diagnostics.Append(diags...)
+
+	ignored, diags := organizationMembersIgnored(ctx, data.IgnoreEmails)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+	members, _, err := r.provider.client.DefaultAPI.GetMembers(ctx).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get organization members, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	// The resource is authoritative from its creation: members that are
+	// already part of the organization but not configured are removed.
+	current, _ := currentOrganizationMembers(members, desired, ignored, nil)
+
+	r.apply(ctx, &data, current, desired, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(organizationMembersID)
+
+	diags = setManagedOrganizationMembers(ctx, resp.Private, desired)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CamundaOrganizationMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data camundaOrganizationMembersData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := organizationMembersFromMap(ctx, data.Members)
+	resp.Diagnostics.Append(diags...)
+
+	ignored, diags := organizationMembersIgnored(ctx, data.IgnoreEmails)
+	resp.Diagnostics.Append(diags...)
+
+	var pending []string
+	if !data.PendingInvitations.IsNull() {
+		diags = data.PendingInvitations.ElementsAs(ctx, &pending, false)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+	members, _, err := r.provider.client.DefaultAPI.GetMembers(ctx).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get organization members, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	current, stillPending := currentOrganizationMembers(members, managed, ignored, pending)
+
+	data.Members, diags = types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, current)
+	resp.Diagnostics.Append(diags...)
+
+	data.PendingInvitations, diags = types.SetValueFrom(ctx, types.StringType, stillPending)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CamundaOrganizationMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state camundaOrganizationMembersData
+
+	diags := req.Plan.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, diags := organizationMembersFromMap(ctx, data.Members)
+	resp.Diagnostics.Append(diags...)
+
+	current, diags := organizationMembersFromMap(ctx, state.Members)
+	resp.Diagnostics.Append(diags...)
+
+	ignored, diags := organizationMembersIgnored(ctx, data.IgnoreEmails)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Members ignored since the last refresh must not be removed.
+	current = withoutIgnoredMembers(current, ignored)
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+
+	r.apply(ctx, &data, current, desired, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = setManagedOrganizationMembers(ctx, resp.Private, desired)
+	resp.Diagnostics.Append(diags...)
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *CamundaOrganizationMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data camundaOrganizationMembersData
+
+	diags := req.State.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, found, diags := managedOrganizationMembers(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+
+	ignored, diags := organizationMembersIgnored(ctx, data.IgnoreEmails)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported resources never applied do not know their configured members.
+	if !found {
+		resp.Diagnostics.AddWarning(
+			"Organization members left unchanged",
+			"The configured members of this resource are unknown, as it was never applied since its import, so no member was removed from the organization.",
+		)
+		return
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+	members, _, err := r.provider.client.DefaultAPI.GetMembers(ctx).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get organization members, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	for _, email := range managed {
+		member := findMember(members, email)
+		if member == nil || containsFold(ignored, email) {
+			continue
+		}
+
+		// The owner cannot leave its own organization.
+		if memberHasRole(*member, organizationRoleOwner) {
+			continue
+		}
+
+		_, err := r.provider.client.DefaultAPI.DeleteMember(ctx, email).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to delete member '%s', got error: %s", email, formatClientError(err)),
+			)
+			return
+		}
+	}
+}
+
+func (r *CamundaOrganizationMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to report when destroying the resource, or before the provider is configured.
+	if req.Plan.Raw.IsNull() || r.provider == nil {
+		return
+	}
+
+	var plan camundaOrganizationMembersData
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || plan.Members.IsUnknown() || plan.IgnoreEmails.IsUnknown() {
+		return
+	}
+
+	desired, diags := organizationMembersFromMap(ctx, plan.Members)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ignored, diags := organizationMembersIgnored(ctx, plan.IgnoreEmails)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current map[string][]string
+
+	if req.State.Raw.IsNull() {
+		ctx = context.WithValue(ctx, console.ContextAccessToken, r.provider.accessToken)
+		members, _, err := r.provider.client.DefaultAPI.GetMembers(ctx).Execute()
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to get organization members, got error: %s", formatClientError(err)),
+			)
+			return
+		}
+
+		current, _ = currentOrganizationMembers(members, desired, ignored, nil)
+	} else {
+		var state camundaOrganizationMembersData
+
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		current, diags = organizationMembersFromMap(ctx, state.Members)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		current = withoutIgnoredMembers(current, ignored)
+	}
+
+	changes := organizationMembersChanges(current, desired)
+	if len(changes) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Organization membership changes",
+		"Applying this plan will change the members of the organization:\n  - "+strings.Join(changes, "\n  - "),
+	)
+}
+
+func (r *CamundaOrganizationMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Without managed members in the state, Read adopts every current member.
+	diags := resp.State.SetAttribute(ctx, path.Root("id"), organizationMembersID)
+	resp.Diagnostics.Append(diags...)
+}
+
+// apply converges the members of the organization from current to desired,
+// and records in data which of the desired members are still invited.
+func (r *CamundaOrganizationMembersResource) apply(ctx context.Context, data *camundaOrganizationMembersData, current, desired map[string][]string, diags *diag.Diagnostics) {
+	for _, email := range sortedKeys(desired) {
+		roles := desired[email]
+
+		if existing, ok := lookupOrganizationMember(current, email); ok && sameRoles(existing, roles) {
+			continue
+		}
+
+		err := setMember(ctx, *r.provider.client, email, roles)
+		if err != nil {
+			diags.AddError(
+				"Unable to update organization member",
+				fmt.Sprintf("Unable to update organization member '%s', got error: %s", email, formatClientError(err)),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Organization member updated", map[string]interface{}{
+			"email": email,
+			"roles": roles,
+		})
+	}
+
+	for _, email := range sortedKeys(current) {
+		if _, ok := lookupOrganizationMember(desired, email); ok {
+			continue
+		}
+
+		_, err := r.provider.client.DefaultAPI.DeleteMember(ctx, email).Execute()
+		if err != nil {
+			diags.AddError(
+				"Client Error",
+				fmt.Sprintf("Unable to delete member '%s', got error: %s", email, formatClientError(err)),
+			)
+			return
+		}
+
+		tflog.Info(ctx, "Organization member removed", map[string]interface{}{
+			"email": email,
+		})
+	}
+
+	members, _, err := r.provider.client.DefaultAPI.GetMembers(ctx).Execute()
+	if err != nil {
+		diags.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to get organization members, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	pending := []string{}
+	for _, email := range sortedKeys(desired) {
+		if findMember(members, email) == nil {
+			pending = append(pending, email)
+		}
+	}
+
+	pendingValue, d := types.SetValueFrom(ctx, types.StringType, pending)
+	diags.Append(d...)
+
+	data.PendingInvitations = pendingValue
+}
+
+// currentOrganizationMembers builds the membership of the organization as seen
+// by the resource: ignored members and the owner (unless managed) are left out,
+// and managed members still in the pending list are kept until they accept
+// their invitation. It also returns the invitations that are still pending.
+func currentOrganizationMembers(members []console.Member, managed map[string][]string, ignored []string, pending []string) (map[string][]string, []string) {
+	current := map[string][]string{}
+
+	for _, member := range members {
+		if containsFold(ignored, member.Email) {
+			continue
+		}
+
+		email := member.Email
+		if key, ok := lookupOrganizationMemberKey(managed, member.Email); ok {
+			// Keep the email as configured, the API may change its case.
+			email = key
+		} else if memberHasRole(member, organizationRoleOwner) {
+			continue
+		}
+
+		current[email] = assignableMemberRoles(member)
+	}
+
+	stillPending := []string{}
+	for _, email := range sortedKeys(managed) {
+		if findMember(members, email) != nil || !containsFold(pending, email) {
+			continue
+		}
+
+		current[email] = managed[email]
+		stillPending = append(stillPending, email)
+	}
+
+	return current, stillPending
+}
+
+// organizationMembersChanges describes, one line per member, the changes
+// needed to go from the current to the desired membership.
+func organizationMembersChanges(current, desired map[string][]string) []string {
+	changes := []string{}
+
+	for _, email := range sortedKeys(desired) {
+		roles := desired[email]
+		existing, ok := lookupOrganizationMember(current, email)
+
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("invite %s with roles: %s", email, strings.Join(sortedRoles(roles), ", ")))
+		case !sameRoles(existing, roles):
+			changes = append(changes, fmt.Sprintf("change roles of %s: %s -> %s", email,
+				strings.Join(sortedRoles(existing), ", "), strings.Join(sortedRoles(roles), ", ")))
+		}
+	}
+
+	for _, email := range sortedKeys(current) {
+		if _, ok := lookupOrganizationMember(desired, email); !ok {
+			changes = append(changes, fmt.Sprintf("remove %s (not configured)", email))
+		}
+	}
+
+	return changes
+}
+
+func organizationMembersFromMap(ctx context.Context, value types.Map) (map[string][]string, diag.Diagnostics) {
+	members := map[string][]string{}
+
+	if value.IsNull() || value.IsUnknown() {
+		return members, nil
+	}
+
+	diags := value.ElementsAs(ctx, &members, false)
+	return members, diags
+}
+
+func organizationMembersIgnored(ctx context.Context, value types.Set) ([]string, diag.Diagnostics) {
+	ignored := []string{}
+
+	if value.IsNull() || value.IsUnknown() {
+		return ignored, nil
+	}
+
+	diags := value.ElementsAs(ctx, &ignored, false)
+	return ignored, diags
+}
+
+// privateState is the private state data of a resource, whose type is
+// internal to the framework.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// setManagedOrganizationMembers saves the emails of the configured members in
+// the private state.
+func setManagedOrganizationMembers(ctx context.Context, private privateState, members map[string][]string) diag.Diagnostics {
+	value, err := json.Marshal(sortedKeys(members))
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Unable to save the configured members", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, organizationMembersManagedKey, value)
+}
+
+// managedOrganizationMembers returns the emails of the configured members saved
+// in the private state, and whether they were saved at all.
+func managedOrganizationMembers(ctx context.Context, private privateState) ([]string, bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, organizationMembersManagedKey)
+	if diags.HasError() || value == nil {
+		return nil, false, diags
+	}
+
+	var emails []string
+	if err := json.Unmarshal(value, &emails); err != nil {
+		diags.AddError("Unable to read the configured members", err.Error())
+		return nil, false, diags
+	}
+
+	return emails, true, diags
+}
+
+// withoutIgnoredMembers returns the members whose email is not ignored.
+func withoutIgnoredMembers(members map[string][]string, ignored []string) map[string][]string {
+	result := map[string][]string{}
+	for email, roles := range members {
+		if !containsFold(ignored, email) {
+			result[email] = roles
+		}
+	}
+
+	return result
+}
+
+func lookupOrganizationMemberKey(members map[string][]string, email string) (string, bool) {
+	for key := range members {
+		if strings.EqualFold(key, email) {
+			return key, true
+		}
+	}
+
+	return "", false
+}
+
+func lookupOrganizationMember(members map[string][]string, email string) ([]string, bool) {
+	key, ok := lookupOrganizationMemberKey(members, email)
+	if !ok {
+		return nil, false
+	}
+
+	return members[key], true
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sameRoles(a, b []string) bool {
+	a, b = sortedRoles(a), sortedRoles(b)
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedRoles(roles []string) []string {
+	sorted := append([]string{}, roles...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkresource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestCurrentOrganizationMembers(t *testing.T) {
+	t.Parallel()
+
+	members := []console.Member{
+		{Email: "owner@example.org", Roles: []console.OrganizationRoleType{"owner", "admin"}},
+		{Email: "Jane@Example.org", Roles: []console.OrganizationRoleType{"developer"}},
+		{Email: "breakglass@example.org", Roles: []console.OrganizationRoleType{"admin"}},
+		{Email: "manual@example.org", Roles: []console.OrganizationRoleType{"visitor"}},
+	}
+
+	managed := map[string][]string{
+		"jane@example.org":    {"developer"},
+		"invited@example.org": {"analyst"},
+		"removed@example.org": {"analyst"},
+	}
+
+	current, pending := currentOrganizationMembers(
+		members,
+		managed,
+		[]string{"breakglass@example.org"},
+		[]string{"invited@example.org"},
+	)
+
+	expected := map[string][]string{
+		"jane@example.org":    {"developer"},
+		"manual@example.org":  {"visitor"},
+		"invited@example.org": {"analyst"},
+	}
+
+	if !reflect.DeepEqual(current, expected) {
+		t.Errorf("unexpected current members:\n got: %v\nwant: %v", current, expected)
+	}
+
+	if !reflect.DeepEqual(pending, []string{"invited@example.org"}) {
+		t.Errorf("unexpected pending invitations: %v", pending)
+	}
+}
+
+func TestOrganizationMembersChanges(t *testing.T) {
+	t.Parallel()
+
+	current := map[string][]string{
+		"jane@example.org":   {"developer"},
+		"john@example.org":   {"admin", "developer"},
+		"manual@example.org": {"visitor"},
+	}
+
+	desired := map[string][]string{
+		"JANE@example.org": {"developer"},
+		"john@example.org": {"developer"},
+		"new@example.org":  {"taskuser", "analyst"},
+	}
+
+	expected := []string{
+		"change roles of john@example.org: admin, developer -> developer",
+		"invite new@example.org with roles: analyst, taskuser",
+		"remove manual@example.org (not configured)",
+	}
+
+	if changes := organizationMembersChanges(current, desired); !reflect.DeepEqual(changes, expected) {
+		t.Errorf("unexpected changes:\n got: %q\nwant: %q", changes, expected)
+	}
+}
+
+func TestAccCamundaOrganizationMembersResource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+	api.addMember("tf-acc-unmanaged@example.org", "visitor")
+	api.addMember("tf-acc-breakglass@example.org", "admin")
+
+	sdkresource.Test(t, sdkresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(*terraform.State) error {
+			expected := []string{fakeOwnerEmail, "tf-acc-breakglass@example.org"}
+			if emails := api.memberEmails(); !reflect.DeepEqual(emails, expected) {
+				return fmt.Errorf("expected %v to be left, got %v", expected, emails)
+			}
+			return nil
+		},
+		Steps: []sdkresource.TestStep{
+			// Create and Read testing, unmanaged members are removed
+			{
+				Config: api.providerConfig() + testAccCamundaOrganizationMembersResourceConfig(`
+    "tf-acc-admin@example.org" = ["admin"]
+    "tf-acc-dev@example.org"   = ["developer"]
+`),
+				Check: sdkresource.ComposeAggregateTestCheckFunc(
+					sdkresource.TestCheckResourceAttr("camunda_organization_members.test", "id", organizationMembersID),
+					sdkresource.TestCheckResourceAttr("camunda_organization_members.test", "members.%", "2"),
+					sdkresource.TestCheckResourceAttr("camunda_organization_members.test", "pending_invitations.#", "0"),
+					func(*terraform.State) error {
+						expected := []string{fakeOwnerEmail, "tf-acc-admin@example.org", "tf-acc-breakglass@example.org", "tf-acc-dev@example.org"}
+						if emails := api.memberEmails(); !reflect.DeepEqual(emails, expected) {
+							return fmt.Errorf("expected members %v, got %v", expected, emails)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "camunda_organization_members.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imports adopt every member but the owner, including ignored ones.
+				ImportStateVerifyIgnore: []string{"ignore_emails", "members.%", "members.tf-acc-breakglass@example.org"},
+			},
+			// Update and Read testing
+			{
+				Config: api.providerConfig() + testAccCamundaOrganizationMembersResourceConfig(`
+    "tf-acc-admin@example.org" = ["admin"]
+    "tf-acc-dev@example.org"   = ["analyst", "developer"]
+    "tf-acc-new@example.org"   = ["visitor"]
+`),
+				Check: sdkresource.ComposeAggregateTestCheckFunc(
+					sdkresource.TestCheckResourceAttr("camunda_organization_members.test", "members.%", "3"),
+					sdkresource.TestCheckResourceAttr("camunda_organization_members.test", "members.tf-acc-dev@example.org.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCamundaOrganizationMembersResourceConfig(members string) string {
+	return fmt.Sprintf(`
+resource "camunda_organization_members" "test" {
+  ignore_emails = ["tf-acc-breakglass@example.org"]
+
+  members = {%s  }
+}
+`, members)
+}
+
+func TestCamundaOrganizationMembersResourceIgnoreDriftedMember(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	r := &CamundaOrganizationMembersResource{provider: api.provider()}
+
+	plan := func(ignored ...string) camundaOrganizationMembersData {
+		members, _ := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, map[string][]string{
+			"jane@example.org": {"developer"},
+		})
+		ignoreEmails, _ := types.SetValueFrom(ctx, types.StringType, ignored)
+		if len(ignored) == 0 {
+			ignoreEmails = types.SetNull(types.StringType)
+		}
+
+		return camundaOrganizationMembersData{
+			Id:                 types.StringValue(organizationMembersID),
+			Members:            members,
+			IgnoreEmails:       ignoreEmails,
+			PendingInvitations: types.SetUnknown(types.StringType),
+		}
+	}
+
+	state, diags := testCreate(t, r, plan())
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	// A break-glass account is added by hand, and detected as drift.
+	api.addMember("breakglass@example.org", "admin")
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	var data camundaOrganizationMembersData
+	state.Get(ctx, &data)
+	if _, ok := data.Members.Elements()["breakglass@example.org"]; !ok {
+		t.Fatalf("expected the added member to be read as drift, got %v", data.Members)
+	}
+
+	// Ignoring it must keep it in the organization.
+	if _, diags := testUpdate(t, r, state, plan("breakglass@example.org")); diags.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", diags)
+	}
+
+	expected := []string{"breakglass@example.org", "jane@example.org", fakeOwnerEmail}
+	if emails := api.memberEmails(); !reflect.DeepEqual(emails, expected) {
+		t.Errorf("expected members %v, got %v", expected, emails)
+	}
+}
+
+func TestCamundaOrganizationMembersResourceDeleteUnmanagedMember(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	r := &CamundaOrganizationMembersResource{provider: api.provider()}
+	s := testResourceSchema(t, r)
+
+	members, _ := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, map[string][]string{
+		"jane@example.org": {"developer"},
+		"john@example.org": {"analyst"},
+	})
+	ignoreEmails, _ := types.SetValueFrom(ctx, types.StringType, []string{"john@example.org"})
+
+	createResp := resource.CreateResponse{State: emptyState(s)}
+	initPrivate(&createResp)
+	r.Create(ctx, resource.CreateRequest{Plan: planFrom(t, s, camundaOrganizationMembersData{
+		Id:                 types.StringUnknown(),
+		Members:            members,
+		IgnoreEmails:       ignoreEmails,
+		PendingInvitations: types.SetUnknown(types.StringType),
+	})}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+
+	// A member added by hand is read as drift, but was never configured.
+	api.addMember("drift@example.org", "admin")
+
+	readResp := resource.ReadResponse{State: createResp.State, Private: createResp.Private}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State, Private: createResp.Private}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", readResp.Diagnostics)
+	}
+
+	deleteResp := resource.DeleteResponse{State: readResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: readResp.State, Private: readResp.Private}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", deleteResp.Diagnostics)
+	}
+
+	// Only the configured members that are not ignored are removed.
+	expected := []string{"drift@example.org", "john@example.org", fakeOwnerEmail}
+	if emails := api.memberEmails(); !reflect.DeepEqual(emails, expected) {
+		t.Errorf("expected members %v, got %v", expected, emails)
+	}
+}
+
+func TestCamundaOrganizationMembersResourceDeleteUnknownMembers(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	api.addMember("jane@example.org", "developer")
+	r := &CamundaOrganizationMembersResource{provider: api.provider()}
+
+	members, _ := types.MapValueFrom(ctx, types.SetType{ElemType: types.StringType}, map[string][]string{
+		"jane@example.org": {"developer"},
+	})
+
+	// An imported resource has no private state.
+	state := stateFrom(t, testResourceSchema(t, r), camundaOrganizationMembersData{
+		Id:                 types.StringValue(organizationMembersID),
+		Members:            members,
+		IgnoreEmails:       types.SetNull(types.StringType),
+		PendingInvitations: types.SetValueMust(types.StringType, nil),
+	})
+
+	diags := testDelete(t, r, state)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a warning about the unknown members, got %v", diags)
+	}
+
+	expected := []string{"jane@example.org", fakeOwnerEmail}
+	if emails := api.memberEmails(); !reflect.DeepEqual(emails, expected) {
+		t.Errorf("expected members %v, got %v", expected, emails)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	console "github.com/camunda-community-hub/console-customer-api-go"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -13,8 +12,9 @@ import (
 var _ datasource.DataSource = &CamundaClusterPlanTypeDataSource{}
 
 type clusterPlanTypeDataSourceData struct {
-	Id   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	RegionId types.String `tfsdk:"region_id"`
 }
 
 type CamundaClusterPlanTypeDataSource struct {
@@ -31,17 +31,21 @@ func (d *CamundaClusterPlanTypeDataSource) Metadata(ctx context.Context, req dat
 
 func (d *CamundaClusterPlanTypeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "clusterPlanType data source",
+		MarkdownDescription: "Look up a cluster plan type available to the organization by name.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the clusterPlanType",
+				MarkdownDescription: "The ID of the cluster plan type",
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the clusterPlanType",
+				MarkdownDescription: "The name of the cluster plan type, such as `Trial Cluster` or `Basic 1x`",
 				Required:            true,
 			},
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the region the cluster plan type is offered in",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -76,8 +80,7 @@ func (d *CamundaClusterPlanTypeDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
-	ctx = context.WithValue(ctx, console.ContextAccessToken, d.provider.accessToken)
-	params, _, err := d.provider.client.DefaultAPI.GetParameters(ctx).Execute()
+	params, err := d.provider.parameters(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -90,6 +93,7 @@ func (d *CamundaClusterPlanTypeDataSource) Read(ctx context.Context, req datasou
 		if clusterPlanType.Name == data.Name.ValueString() {
 			data.Id = types.StringValue(clusterPlanType.Uuid)
 			data.Name = types.StringValue(clusterPlanType.Name)
+			data.RegionId = types.StringValue(clusterPlanType.Region.Uuid)
 
 			diags = resp.State.Set(ctx, &data)
 			resp.Diagnostics.Append(diags...)
@@ -98,8 +102,13 @@ func (d *CamundaClusterPlanTypeDataSource) Read(ctx context.Context, req datasou
 		}
 	}
 
+	names := []string{}
+	for _, clusterPlanType := range params.ClusterPlanTypes {
+		names = append(names, clusterPlanType.Name)
+	}
+
 	resp.Diagnostics.AddError(
 		"Client Error",
-		fmt.Sprintf("Camunda Cloud clusterPlanType '%s' not found.", data.Name.ValueString()),
+		fmt.Sprintf("Camunda Cloud cluster plan type '%s' not found. Valid names are: %s.", data.Name.ValueString(), validNames(names)),
 	)
 }
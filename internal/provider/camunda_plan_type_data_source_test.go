@@ -1 +1,29 @@
 package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCamundaClusterPlanTypeDataSource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: api.providerConfig() + `
+data "camunda_cluster_plan_type" "test" {
+  name = "Basic 1x"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.camunda_cluster_plan_type.test", "id", fakePlanTypeBasicID),
+					resource.TestCheckResourceAttr("data.camunda_cluster_plan_type.test", "region_id", fakeRegionBelgiumID),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/camunda-community-hub/terraform-provider-camunda/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CamundaClusterPlanTypesDataSource{}
+
+type clusterPlanTypesDataSourceData struct {
+	Id        types.String           `tfsdk:"id"`
+	NameRegex types.String           `tfsdk:"name_regex"`
+	PlanTypes []clusterPlanTypeModel `tfsdk:"plan_types"`
+}
+
+type clusterPlanTypeModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type CamundaClusterPlanTypesDataSource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaClusterPlanTypesDataSource() datasource.DataSource {
+	return &CamundaClusterPlanTypesDataSource{}
+}
+
+func (d *CamundaClusterPlanTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster_plan_types"
+}
+
+func (d *CamundaClusterPlanTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the cluster plan types available to the organization",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return the cluster plan types whose name matches this regular expression",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsRegex{},
+				},
+			},
+			"plan_types": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the cluster plan type",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the cluster plan type",
+							Computed:            true,
+						},
+					},
+				},
+				MarkdownDescription: "The cluster plan types matching the filters",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CamundaClusterPlanTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *CamundaClusterPlanTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data clusterPlanTypesDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := d.provider.parameters(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read parameters, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid name_regex",
+			fmt.Sprintf("Unable to parse the regular expression '%s', got error: %s", data.NameRegex.ValueString(), err),
+		)
+		return
+	}
+
+	data.Id = types.StringValue("plan_types")
+	data.PlanTypes = []clusterPlanTypeModel{}
+
+	for _, clusterPlanType := range params.ClusterPlanTypes {
+		if !nameRegex.MatchString(clusterPlanType.Name) {
+			continue
+		}
+
+		data.PlanTypes = append(data.PlanTypes, clusterPlanTypeModel{
+			Id:   types.StringValue(clusterPlanType.Uuid),
+			Name: types.StringValue(clusterPlanType.Name),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
@@ -4,17 +4,24 @@ import (
 	"context"
 	"fmt"
 
-	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &CamundaRegionDataSource{}
 
 type regionDataSourceData struct {
-	Id   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
+	Id            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Key           types.String   `tfsdk:"key"`
+	Zone          types.String   `tfsdk:"zone"`
+	CloudProvider types.String   `tfsdk:"cloud_provider"`
+	Available     types.Bool     `tfsdk:"available"`
+	PlanTypeIds   []types.String `tfsdk:"plan_type_ids"`
 }
 
 type CamundaRegionDataSource struct {
@@ -39,8 +46,34 @@ func (d *CamundaRegionDataSource) Schema(ctx context.Context, req datasource.Sch
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the region",
-				Required:            true,
+				MarkdownDescription: "The display name of the region, such as `Belgium, Europe (europe-west1)`. Exactly one of `name` or `key` must be set.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("key")),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the region, such as `europe-west1`. Exactly one of `name` or `key` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "The zone of the region, as used in the cluster addresses",
+				Computed:            true,
+			},
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "The cloud provider hosting the region, `aws` or `gcp`. The Console API does not report it, so it is derived from the format of the region key, and it is empty when the key matches neither format.",
+				Computed:            true,
+			},
+			"available": schema.BoolAttribute{
+				MarkdownDescription: "Whether clusters can be created in this region with one of the cluster plan types of the organization",
+				Computed:            true,
+			},
+			"plan_type_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the cluster plan types of the organization available in this region",
+				Computed:            true,
+				ElementType:         types.StringType,
 			},
 		},
 	}
@@ -76,8 +109,7 @@ func (d *CamundaRegionDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	ctx = context.WithValue(ctx, console.ContextAccessToken, d.provider.accessToken)
-	params, _, err := d.provider.client.DefaultAPI.GetParameters(ctx).Execute()
+	params, err := d.provider.parameters(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Client Error",
@@ -86,12 +118,27 @@ func (d *CamundaRegionDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	byKey := !data.Key.IsNull()
 	wantedRegion := data.Name.ValueString()
+	if byKey {
+		wantedRegion = data.Key.ValueString()
+	}
 
 	for _, region := range params.Regions {
-		if region.Name == wantedRegion {
+		if (byKey && region.Region == wantedRegion) || (!byKey && region.Name == wantedRegion) {
 			data.Id = types.StringValue(region.Uuid)
 			data.Name = types.StringValue(region.Name)
+			data.Key = types.StringValue(region.Region)
+			data.Zone = types.StringValue(region.Zone)
+			data.CloudProvider = types.StringValue(regionCloudProvider(region))
+
+			data.PlanTypeIds = []types.String{}
+			for _, planType := range params.ClusterPlanTypes {
+				if planType.Region.Uuid == region.Uuid {
+					data.PlanTypeIds = append(data.PlanTypeIds, types.StringValue(planType.Uuid))
+				}
+			}
+			data.Available = types.BoolValue(len(data.PlanTypeIds) > 0)
 
 			diags = resp.State.Set(ctx, &data)
 			resp.Diagnostics.Append(diags...)
@@ -100,8 +147,25 @@ func (d *CamundaRegionDataSource) Read(ctx context.Context, req datasource.ReadR
 		}
 	}
 
+	names := []string{}
+	for _, region := range params.Regions {
+		if byKey {
+			names = append(names, region.Region)
+		} else {
+			names = append(names, region.Name)
+		}
+	}
+
+	if byKey {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Camunda Cloud region with key '%s' not found. Valid keys are: %s.", wantedRegion, validNames(names)),
+		)
+		return
+	}
+
 	resp.Diagnostics.AddError(
 		"Client Error",
-		fmt.Sprintf("Camunda Cloud region '%s' not found.", wantedRegion),
+		fmt.Sprintf("Camunda Cloud region '%s' not found. Valid names are: %s.", wantedRegion, validNames(names)),
 	)
 }
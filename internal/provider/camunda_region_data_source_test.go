@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCamundaRegionDataSource(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+	api.addRegion(console.ParametersRegionsInner{Uuid: fakeUUID("region", 3), Name: "Moon (moon-base)", Region: "moon-base", Zone: "moon-1"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: api.providerConfig() + `
+data "camunda_region" "belgium" {
+  key = "europe-west1"
+}
+
+data "camunda_region" "frankfurt" {
+  key = "eu-central-1"
+}
+
+data "camunda_region" "moon" {
+  key = "moon-base"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.camunda_region.belgium", "id", fakeRegionBelgiumID),
+					resource.TestCheckResourceAttr("data.camunda_region.belgium", "name", "Belgium, Europe (europe-west1)"),
+					resource.TestCheckResourceAttr("data.camunda_region.belgium", "cloud_provider", cloudProviderGCP),
+					resource.TestCheckResourceAttr("data.camunda_region.belgium", "zone", "bru-2"),
+					resource.TestCheckResourceAttr("data.camunda_region.belgium", "available", "true"),
+					resource.TestCheckResourceAttr("data.camunda_region.belgium", "plan_type_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.camunda_region.frankfurt", "cloud_provider", cloudProviderAWS),
+					resource.TestCheckResourceAttr("data.camunda_region.frankfurt", "zone", "fra-1"),
+					resource.TestCheckResourceAttr("data.camunda_region.frankfurt", "available", "false"),
+					resource.TestCheckResourceAttr("data.camunda_region.frankfurt", "plan_type_ids.#", "0"),
+					// The cloud provider of keys in an unknown format is not recognized.
+					resource.TestCheckResourceAttr("data.camunda_region.moon", "cloud_provider", ""),
+				),
+			},
+			// Unknown region
+			{
+				Config: api.providerConfig() + `
+data "camunda_region" "unknown" {
+  key = "mars-north1"
+}
+`,
+				ExpectError: regexp.MustCompile(`Valid keys are:\s+'eu-central-1',\s+'europe-west1',\s+'moon-base'`),
+			},
+		},
+	})
+}
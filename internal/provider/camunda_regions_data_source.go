@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/camunda-community-hub/terraform-provider-camunda/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CamundaRegionsDataSource{}
+
+type regionsDataSourceData struct {
+	Id            types.String  `tfsdk:"id"`
+	NameRegex     types.String  `tfsdk:"name_regex"`
+	CloudProvider types.String  `tfsdk:"cloud_provider"`
+	Regions       []regionModel `tfsdk:"regions"`
+}
+
+type regionModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Key           types.String `tfsdk:"key"`
+	Zone          types.String `tfsdk:"zone"`
+	CloudProvider types.String `tfsdk:"cloud_provider"`
+}
+
+type CamundaRegionsDataSource struct {
+	provider *CamundaCloudProvider
+}
+
+func NewCamundaRegionsDataSource() datasource.DataSource {
+	return &CamundaRegionsDataSource{}
+}
+
+func (d *CamundaRegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *CamundaRegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the regions available to the organization",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID",
+				Computed:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return the regions whose name matches this regular expression",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.IsRegex{},
+				},
+			},
+			"cloud_provider": schema.StringAttribute{
+				MarkdownDescription: "Only return the regions hosted by this cloud provider. Must be one of: `aws` or `gcp`. Regions whose cloud provider is not recognized are left out.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(cloudProviderAWS, cloudProviderGCP),
+				},
+			},
+			"regions": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the region",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the region",
+							Computed:            true,
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The key of the region, such as `europe-west1`",
+							Computed:            true,
+						},
+						"zone": schema.StringAttribute{
+							MarkdownDescription: "The zone of the region, as used in the cluster addresses",
+							Computed:            true,
+						},
+						"cloud_provider": schema.StringAttribute{
+							MarkdownDescription: "The cloud provider hosting the region, `aws` or `gcp`. The Console API does not report it, so it is derived from the format of the region key, and it is empty when the key matches neither format.",
+							Computed:            true,
+						},
+					},
+				},
+				MarkdownDescription: "The regions matching the filters",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CamundaRegionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.provider = provider
+}
+
+func (d *CamundaRegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data regionsDataSourceData
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params, err := d.provider.parameters(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Unable to read parameters, got error: %s", formatClientError(err)),
+		)
+		return
+	}
+
+	nameRegex, err := regexp.Compile(data.NameRegex.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid name_regex",
+			fmt.Sprintf("Unable to parse the regular expression '%s', got error: %s", data.NameRegex.ValueString(), err),
+		)
+		return
+	}
+
+	data.Id = types.StringValue("regions")
+	data.Regions = []regionModel{}
+
+	for _, region := range params.Regions {
+		cloudProvider := regionCloudProvider(region)
+
+		if !nameRegex.MatchString(region.Name) {
+			continue
+		}
+
+		if !data.CloudProvider.IsNull() && data.CloudProvider.ValueString() != cloudProvider {
+			continue
+		}
+
+		data.Regions = append(data.Regions, regionModel{
+			Id:            types.StringValue(region.Uuid),
+			Name:          types.StringValue(region.Name),
+			Key:           types.StringValue(region.Region),
+			Zone:          types.StringValue(region.Zone),
+			CloudProvider: types.StringValue(cloudProvider),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+}
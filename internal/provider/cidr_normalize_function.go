@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/camunda-community-hub/terraform-provider-camunda/internal/validators"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &CIDRNormalizeFunction{}
+
+type CIDRNormalizeFunction struct{}
+
+func NewCIDRNormalizeFunction() function.Function {
+	return &CIDRNormalizeFunction{}
+}
+
+func (f *CIDRNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_normalize"
+}
+
+func (f *CIDRNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Normalize an IP address or network",
+		MarkdownDescription: "Normalizes an IP address or network, as accepted by the `ip` of `camunda_cluster_ip_whitelist`, in CIDR notation: an address becomes a network of a single address, such as `10.0.0.1/32`, and the host bits of a network are cleared, such as `10.0.0.0/24` for `10.0.0.1/24`.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ip",
+				MarkdownDescription: "The IP address or network",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CIDRNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ip string
+
+	resp.Error = req.Arguments.Get(ctx, &ip)
+	if resp.Error != nil {
+		return
+	}
+
+	network, err := validators.ParseIPNetwork(ip)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid network value: %s", err))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, network.String())
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	// The backup is being taken.
+	backupStateInProgress = "IN_PROGRESS"
+	// The backup was taken and can be restored.
+	backupStateCompleted = "COMPLETED"
+)
+
+// The components of a cluster that can be waited on.
+const (
+	clusterComponentZeebe      = "zeebe"
+	clusterComponentOperate    = "operate"
+	clusterComponentTasklist   = "tasklist"
+	clusterComponentOptimize   = "optimize"
+	clusterComponentConnectors = "connectors"
+)
+
+var clusterComponents = []string{
+	clusterComponentZeebe,
+	clusterComponentOperate,
+	clusterComponentTasklist,
+	clusterComponentOptimize,
+	clusterComponentConnectors,
+}
+
+// clusterStages are the stages a cluster can be tagged with.
+var clusterStages = []string{"dev", "test", "stage", "prod"}
+
+// mergeLabels returns all the labels of a cluster: the default labels of the
+// provider, overridden by the labels of the cluster.
+func mergeLabels(defaults map[string]string, labels map[string]string) map[string]string {
+	merged := map[string]string{}
+	maps.Copy(merged, defaults)
+	maps.Copy(merged, labels)
+
+	return merged
+}
+
+// resourceLabels returns the labels read from the API that belong to the
+// labels attribute of a cluster: those that were configured, and those that
+// differ from the default labels of the provider, so that labels added outside
+// of Terraform are detected as drift.
+func resourceLabels(labels map[string]string, defaults map[string]string, configured map[string]string) map[string]string {
+	result := map[string]string{}
+	for key, value := range labels {
+		_, isConfigured := configured[key]
+		defaultValue, isDefault := defaults[key]
+
+		if isConfigured || !isDefault || defaultValue != value {
+			result[key] = value
+		}
+	}
+
+	// Keep the labels null when none are configured nor added.
+	if len(result) == 0 && configured == nil {
+		return nil
+	}
+
+	return result
+}
+
+// componentStatus returns the status of a component of a cluster, empty if the
+// component is not reported.
+func componentStatus(status console.ClusterStatus, component string) console.ClusterComponentStatus {
+	switch component {
+	case clusterComponentZeebe:
+		return status.GetZeebeStatus()
+	case clusterComponentOperate:
+		return status.GetOperateStatus()
+	case clusterComponentTasklist:
+		return status.GetTasklistStatus()
+	case clusterComponentOptimize:
+		return status.GetOptimizeStatus()
+	case clusterComponentConnectors:
+		return status.GetConnectorsStatus()
+	}
+
+	return ""
+}
+
+// clusterHealth returns the status of a cluster, which is only healthy once
+// the given components are healthy too. Components are only reported once they
+// are being created, so a component still unreported once the cluster is
+// healthy is not part of it and can never get healthy.
+func clusterHealth(status console.ClusterStatus, components []string) (console.ClusterComponentStatus, error) {
+	if status.Ready != console.CLUSTERCOMPONENTSTATUS_HEALTHY {
+		return status.Ready, nil
+	}
+
+	for _, component := range components {
+		switch componentStatus(status, component) {
+		case console.CLUSTERCOMPONENTSTATUS_HEALTHY:
+			continue
+		case "":
+			return "", fmt.Errorf("component %s is not reported by the healthy cluster, check that its plan and generation include it", component)
+		default:
+			return componentStatus(status, component), nil
+		}
+	}
+
+	return console.CLUSTERCOMPONENTSTATUS_HEALTHY, nil
+}
+
+// describeClusterStatus returns the status of a cluster and of each of its
+// components, for diagnostics.
+func describeClusterStatus(status console.ClusterStatus) string {
+	description := fmt.Sprintf("ready: %s", status.Ready)
+	for _, component := range clusterComponents {
+		if state := componentStatus(status, component); state != "" {
+			description += fmt.Sprintf(", %s: %s", component, state)
+		}
+	}
+
+	return description
+}
+
+// waitForClusterHealthy polls a cluster until it and the given components are
+// marked healthy, for as long as it is in one of the pending states. The last
+// observed cluster is returned along with any error, if it could be read.
+func waitForClusterHealthy(ctx context.Context, provider *CamundaCloudProvider, clusterId string, components []string, pending []console.ClusterComponentStatus, timeout time.Duration) (*console.Cluster, error) {
+	var last *console.Cluster
+
+	stateChange := &retry.StateChangeConf{
+		// The cluster states that we need to keep waiting on
+		Pending: clusterStatuses(pending),
+
+		// The cluster states that we would like to reach
+		Target: []string{
+			string(console.CLUSTERCOMPONENTSTATUS_HEALTHY),
+		},
+
+		// How many times the target state has to be reached to continue.
+		ContinuousTargetOccurence: 2,
+
+		Refresh: func() (interface{}, string, error) {
+			cluster, _, err := provider.client.DefaultAPI.
+				GetCluster(ctx, clusterId).
+				Execute()
+
+			if err != nil {
+				return nil, "", err
+			}
+			last = cluster
+
+			status, err := clusterHealth(cluster.Status, components)
+			if err != nil {
+				return nil, "", err
+			}
+
+			tflog.Info(ctx, "Camunda cluster status", map[string]interface{}{
+				"clusterID":     cluster.Uuid,
+				"clusterStatus": status,
+			})
+
+			return cluster, string(status), nil
+		},
+
+		Timeout:    timeout,
+		Delay:      clusterStatusDelay,
+		MinTimeout: clusterStatusPollInterval,
+	}
+
+	cluster, err := stateChange.WaitForStateContext(ctx)
+	if err != nil {
+		return last, err
+	}
+
+	return cluster.(*console.Cluster), nil
+}
+
+func clusterStatuses(statuses []console.ClusterComponentStatus) []string {
+	names := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		names = append(names, string(status))
+	}
+
+	return names
+}
+
+// findBackup looks up a backup of a cluster by ID.
+func findBackup(backups []console.BackupDto, backupId string) *console.BackupDto {
+	for i := range backups {
+		if backups[i].BackupId == backupId {
+			return &backups[i]
+		}
+	}
+
+	return nil
+}
+
+// waitForBackupCompleted polls a backup of a cluster until it is completed.
+func waitForBackupCompleted(ctx context.Context, provider *CamundaCloudProvider, clusterId string, backupId string, timeout time.Duration) (*console.BackupDto, error) {
+	stateChange := &retry.StateChangeConf{
+		Pending: []string{backupStateInProgress},
+		Target:  []string{backupStateCompleted},
+
+		Refresh: func() (interface{}, string, error) {
+			backups, _, err := provider.client.DefaultAPI.
+				GetBackups(ctx, clusterId).
+				Execute()
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			backup := findBackup(backups, backupId)
+			if backup == nil {
+				return nil, "", fmt.Errorf("backup %s of cluster %s not found", backupId, clusterId)
+			}
+
+			tflog.Info(ctx, "Camunda cluster backup state", map[string]interface{}{
+				"clusterID":   clusterId,
+				"backupID":    backupId,
+				"backupState": backup.BackupState,
+			})
+
+			return backup, backup.BackupState, nil
+		},
+
+		Timeout:    timeout,
+		Delay:      clusterStatusDelay,
+		MinTimeout: clusterStatusPollInterval,
+	}
+
+	backup, err := stateChange.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return backup.(*console.BackupDto), nil
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"maps"
+	"testing"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+)
+
+func TestResourceLabels(t *testing.T) {
+	t.Parallel()
+
+	defaults := map[string]string{"team": "platform"}
+
+	testCases := map[string]struct {
+		labels     map[string]string
+		configured map[string]string
+		expect     map[string]string
+	}{
+		"no labels": {
+			expect: nil,
+		},
+		"default labels only": {
+			labels: map[string]string{"team": "platform"},
+			expect: nil,
+		},
+		"configured but empty": {
+			labels:     map[string]string{"team": "platform"},
+			configured: map[string]string{},
+			expect:     map[string]string{},
+		},
+		"overridden default label": {
+			labels: map[string]string{"team": "payments"},
+			expect: map[string]string{"team": "payments"},
+		},
+		"configured default label": {
+			labels:     map[string]string{"team": "platform"},
+			configured: map[string]string{"team": "platform"},
+			expect:     map[string]string{"team": "platform"},
+		},
+		"added label": {
+			labels:     map[string]string{"team": "platform", "env": "dev", "owner": "jane"},
+			configured: map[string]string{"env": "dev"},
+			expect:     map[string]string{"env": "dev", "owner": "jane"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := resourceLabels(testCase.labels, defaults, testCase.configured)
+			if (got == nil) != (testCase.expect == nil) || !maps.Equal(got, testCase.expect) {
+				t.Errorf("expected %v, got %v", testCase.expect, got)
+			}
+		})
+	}
+}
+
+func TestClusterHealth(t *testing.T) {
+	t.Parallel()
+
+	creating := console.CLUSTERCOMPONENTSTATUS_CREATING
+	healthy := console.CLUSTERCOMPONENTSTATUS_HEALTHY
+	unhealthy := console.CLUSTERCOMPONENTSTATUS_UNHEALTHY
+
+	testCases := map[string]struct {
+		status     console.ClusterStatus
+		components []string
+		expect     console.ClusterComponentStatus
+		error      bool
+	}{
+		"creating": {
+			status: console.ClusterStatus{Ready: creating, OperateStatus: &healthy},
+			expect: creating,
+		},
+		"healthy": {
+			status: console.ClusterStatus{Ready: healthy, OperateStatus: &creating},
+			expect: healthy,
+		},
+		"healthy components": {
+			status:     console.ClusterStatus{Ready: healthy, ZeebeStatus: &healthy, OperateStatus: &healthy},
+			components: []string{clusterComponentZeebe, clusterComponentOperate},
+			expect:     healthy,
+		},
+		"creating component": {
+			status:     console.ClusterStatus{Ready: healthy, ZeebeStatus: &healthy, OperateStatus: &creating},
+			components: []string{clusterComponentZeebe, clusterComponentOperate},
+			expect:     creating,
+		},
+		"unhealthy component": {
+			status:     console.ClusterStatus{Ready: healthy, ConnectorsStatus: &unhealthy},
+			components: []string{clusterComponentConnectors},
+			expect:     unhealthy,
+		},
+		"unreported component": {
+			status:     console.ClusterStatus{Ready: healthy},
+			components: []string{clusterComponentOptimize},
+			error:      true,
+		},
+		"unreported component while creating": {
+			status:     console.ClusterStatus{Ready: creating},
+			components: []string{clusterComponentOptimize},
+			expect:     creating,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := clusterHealth(testCase.status, testCase.components)
+			if (err != nil) != testCase.error {
+				t.Fatalf("expected an error: %t, got %v", testCase.error, err)
+			}
+
+			if got != testCase.expect {
+				t.Errorf("expected %s, got %s", testCase.expect, got)
+			}
+		})
+	}
+}
+
+func TestDescribeClusterStatus(t *testing.T) {
+	creating := console.CLUSTERCOMPONENTSTATUS_CREATING
+	healthy := console.CLUSTERCOMPONENTSTATUS_HEALTHY
+
+	status := console.ClusterStatus{Ready: healthy, ZeebeStatus: &healthy, OperateStatus: &creating}
+
+	expect := "ready: Healthy, zeebe: Healthy, operate: Creating"
+	if got := describeClusterStatus(status); got != expect {
+		t.Errorf("expected %q, got %q", expect, got)
+	}
+}
@@ -0,0 +1,855 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const (
+	fakeClientID     = "test-client"
+	fakeClientSecret = "test-secret"
+	fakeAccessToken  = "test-token"
+
+	fakeOwnerEmail = "owner@example.org"
+)
+
+// IDs of the parameters served by the fake Console API.
+var (
+	fakeChannelStableID = fakeUUID("channel", 1)
+	fakeChannelAlphaID  = fakeUUID("channel", 2)
+
+	fakeGeneration86ID = fakeUUID("generation", 1)
+	fakeGeneration85ID = fakeUUID("generation", 2)
+	fakeGeneration87ID = fakeUUID("generation", 3)
+
+	fakeRegionBelgiumID   = fakeUUID("region", 1)
+	fakeRegionFrankfurtID = fakeUUID("region", 2)
+
+	fakePlanTypeTrialID = fakeUUID("plan", 1)
+	fakePlanTypeBasicID = fakeUUID("plan", 2)
+)
+
+// fakeConsoleAPI is an in-memory stand-in for the Console API and its OAuth
+// token endpoint. Created and woken up clusters report `CREATING` for the
+// first creatingPolls reads before turning `HEALTHY`, except for their
+// stalledComponents which keep `CREATING` and their unreportedComponents which
+// are left out of their status, backups are completed after as many
+// reads, and invitations are accepted right away unless pendingInvites is set.
+type fakeConsoleAPI struct {
+	mu     sync.Mutex
+	server *httptest.Server
+
+	creatingPolls        int
+	stalledComponents    []string
+	unreportedComponents []string
+	pendingInvites       bool
+	nextID               int
+
+	parameters console.Parameters
+	clusters   map[string]*console.Cluster
+	polls      map[string]int
+	clients    map[string]map[string]*console.CreatedClusterClient
+	secrets    map[string]map[string]string
+	backups    map[string][]*console.BackupDto
+	members    map[string]*console.Member
+}
+
+func newFakeConsoleAPI(t *testing.T) *fakeConsoleAPI {
+	t.Helper()
+
+	api := &fakeConsoleAPI{
+		creatingPolls: 1,
+		parameters:    fakeParameters(),
+		clusters:      map[string]*console.Cluster{},
+		polls:         map[string]int{},
+		clients:       map[string]map[string]*console.CreatedClusterClient{},
+		secrets:       map[string]map[string]string{},
+		backups:       map[string][]*console.BackupDto{},
+		members: map[string]*console.Member{
+			fakeOwnerEmail: {Name: "Owner", Email: fakeOwnerEmail, Roles: []console.OrganizationRoleType{organizationRoleOwner}},
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth/token", api.token)
+
+	mux.HandleFunc("GET /clusters", api.authorized(api.getClusters))
+	mux.HandleFunc("POST /clusters", api.authorized(api.createCluster))
+	mux.HandleFunc("GET /clusters/parameters", api.authorized(api.getParameters))
+	mux.HandleFunc("GET /clusters/{clusterId}", api.authorized(api.getCluster))
+	mux.HandleFunc("PATCH /clusters/{clusterId}", api.authorized(api.updateCluster))
+	mux.HandleFunc("DELETE /clusters/{clusterId}", api.authorized(api.deleteCluster))
+	mux.HandleFunc("PUT /clusters/{clusterId}/ipwhitelist", api.authorized(api.updateIPWhitelist))
+	mux.HandleFunc("PUT /clusters/{clusterId}/wake", api.authorized(api.wakeCluster))
+
+	mux.HandleFunc("GET /clusters/{clusterId}/clients", api.authorized(api.getClients))
+	mux.HandleFunc("POST /clusters/{clusterId}/clients", api.authorized(api.createClient))
+	mux.HandleFunc("GET /clusters/{clusterId}/clients/{clientId}", api.authorized(api.getClient))
+	mux.HandleFunc("DELETE /clusters/{clusterId}/clients/{clientId}", api.authorized(api.deleteClient))
+
+	mux.HandleFunc("GET /clusters/{clusterId}/secrets", api.authorized(api.getSecrets))
+	mux.HandleFunc("POST /clusters/{clusterId}/secrets", api.authorized(api.createSecret))
+	mux.HandleFunc("PUT /clusters/{clusterId}/secrets/{secretName}", api.authorized(api.updateSecret))
+	mux.HandleFunc("DELETE /clusters/{clusterId}/secrets/{secretName}", api.authorized(api.deleteSecret))
+
+	mux.HandleFunc("GET /clusters/{clusterId}/backups", api.authorized(api.getBackups))
+	mux.HandleFunc("POST /clusters/{clusterId}/backups", api.authorized(api.createBackup))
+	mux.HandleFunc("DELETE /clusters/{clusterId}/backups/{backupId}", api.authorized(api.deleteBackup))
+
+	mux.HandleFunc("GET /members", api.authorized(api.getMembers))
+	mux.HandleFunc("PUT /members/{email}", api.authorized(api.updateMember))
+	mux.HandleFunc("DELETE /members/{email}", api.authorized(api.deleteMember))
+
+	api.server = httptest.NewServer(mux)
+	t.Cleanup(api.server.Close)
+
+	return api
+}
+
+func fakeUUID(kind string, n int) string {
+	prefix := map[string]string{"channel": "c", "generation": "d", "region": "e", "plan": "f"}[kind]
+	return fmt.Sprintf("%s0000000-0000-4000-8000-%012d", prefix, n)
+}
+
+func fakeParameters() console.Parameters {
+	generation86 := console.GenerationsInner{Uuid: fakeGeneration86ID, Name: "Camunda 8.6.3"}
+	generation85 := console.GenerationsInner{Uuid: fakeGeneration85ID, Name: "Camunda 8.5.9"}
+	generation87 := console.GenerationsInner{Uuid: fakeGeneration87ID, Name: "Camunda 8.7.0-alpha2"}
+
+	belgium := console.ParametersRegionsInner{
+		Uuid:   fakeRegionBelgiumID,
+		Name:   "Belgium, Europe (europe-west1)",
+		Region: "europe-west1",
+		Zone:   "bru-2",
+	}
+	frankfurt := console.ParametersRegionsInner{
+		Uuid:   fakeRegionFrankfurtID,
+		Name:   "Frankfurt, Europe (eu-central-1)",
+		Region: "eu-central-1",
+		Zone:   "fra-1",
+	}
+
+	return console.Parameters{
+		Channels: []console.ParametersChannelsInner{
+			{
+				Uuid:               fakeChannelStableID,
+				Name:               "Stable",
+				IsDefault:          true,
+				DefaultGeneration:  generation86,
+				AllowedGenerations: []console.GenerationsInner{generation86, generation85},
+			},
+			{
+				Uuid:               fakeChannelAlphaID,
+				Name:               "Alpha",
+				DefaultGeneration:  generation87,
+				AllowedGenerations: []console.GenerationsInner{generation87},
+			},
+		},
+		ClusterPlanTypes: []console.ParametersClusterPlanTypesInner{
+			{
+				Uuid:   fakePlanTypeTrialID,
+				Name:   "Trial Cluster",
+				Region: console.ClusterRegion{Uuid: belgium.Uuid, Name: belgium.Name},
+			},
+			{
+				Uuid:   fakePlanTypeBasicID,
+				Name:   "Basic 1x",
+				Region: console.ClusterRegion{Uuid: belgium.Uuid, Name: belgium.Name},
+			},
+		},
+		Regions: []console.ParametersRegionsInner{belgium, frankfurt},
+	}
+}
+
+// provider returns a provider configured against the fake API.
+func (api *fakeConsoleAPI) provider() *CamundaCloudProvider {
+	apiUrl, _ := url.Parse(api.server.URL)
+
+	cfg := console.NewConfiguration()
+	cfg.Scheme = apiUrl.Scheme
+	cfg.Host = apiUrl.Host
+
+	return &CamundaCloudProvider{
+		client:      console.NewAPIClient(cfg),
+		accessToken: fakeAccessToken,
+		credentials: &clientcredentials.Config{
+			ClientID:     fakeClientID,
+			ClientSecret: fakeClientSecret,
+			TokenURL:     api.server.URL + "/oauth/token",
+		},
+		parametersCache: newParametersCache(parametersCacheTTL),
+	}
+}
+
+// providerConfig returns the provider block pointing Terraform at the fake API.
+func (api *fakeConsoleAPI) providerConfig() string {
+	return fmt.Sprintf(`
+provider "camunda" {
+  client_id     = %[1]q
+  client_secret = %[2]q
+  api_url       = %[3]q
+  token_url     = "%[3]s/oauth/token"
+}
+`, fakeClientID, fakeClientSecret, api.server.URL)
+}
+
+func (api *fakeConsoleAPI) addCluster(id string, ipWhitelist ...console.ClusterIpallowlistInner) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.clusters[id] = &console.Cluster{
+		Uuid:        id,
+		Name:        id,
+		Status:      fakeClusterStatus(console.CLUSTERCOMPONENTSTATUS_HEALTHY),
+		Ipwhitelist: ipWhitelist,
+	}
+}
+
+// addRegion makes a region available to the organization, without any
+// cluster plan type.
+func (api *fakeConsoleAPI) addRegion(region console.ParametersRegionsInner) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.parameters.Regions = append(api.parameters.Regions, region)
+}
+
+// labelCluster replaces the labels of a cluster, as users can do in the
+// Console.
+func (api *fakeConsoleAPI) labelCluster(id string, labels map[string]string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.clusters[id].Labels = labels
+}
+
+// clusterLabels returns the labels of a cluster.
+func (api *fakeConsoleAPI) clusterLabels(id string) map[string]string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	return api.clusters[id].Labels
+}
+
+// hibernateCluster puts a cluster to sleep, as Camunda SaaS does with idle
+// trial clusters.
+func (api *fakeConsoleAPI) hibernateCluster(id string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.clusters[id].Status = fakeClusterStatus(console.CLUSTERCOMPONENTSTATUS_UNHEALTHY)
+}
+
+func (api *fakeConsoleAPI) addMember(email string, roles ...string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	member := &console.Member{Email: email, Name: email}
+	for _, role := range roles {
+		member.Roles = append(member.Roles, console.OrganizationRoleType(role))
+	}
+
+	api.members[strings.ToLower(email)] = member
+}
+
+// addClient adds a client to a cluster and returns its ID.
+func (api *fakeConsoleAPI) addClient(clusterID string, name string) string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	id := api.newID()
+	if api.clients[clusterID] == nil {
+		api.clients[clusterID] = map[string]*console.CreatedClusterClient{}
+	}
+	api.clients[clusterID][id] = &console.CreatedClusterClient{Name: name, Uuid: id, ClientId: id, ClientSecret: "secret-" + id, Permissions: validScopes}
+
+	return id
+}
+
+func (api *fakeConsoleAPI) addSecret(clusterID string, name string, value string) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	if api.secrets[clusterID] == nil {
+		api.secrets[clusterID] = map[string]string{}
+	}
+	api.secrets[clusterID][name] = value
+}
+
+func (api *fakeConsoleAPI) clusterNames() []string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	names := []string{}
+	for _, cluster := range api.clusters {
+		names = append(names, cluster.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (api *fakeConsoleAPI) clientNames(clusterID string) []string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	names := []string{}
+	for _, client := range api.clients[clusterID] {
+		names = append(names, client.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (api *fakeConsoleAPI) secretNames(clusterID string) []string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	return sortedKeys(api.secrets[clusterID])
+}
+
+func (api *fakeConsoleAPI) ipWhitelist(id string) []console.ClusterIpallowlistInner {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	return api.clusters[id].Ipwhitelist
+}
+
+func (api *fakeConsoleAPI) clusterCount() int {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	return len(api.clusters)
+}
+
+func (api *fakeConsoleAPI) memberEmails() []string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	emails := []string{}
+	for _, member := range api.members {
+		emails = append(emails, member.Email)
+	}
+	sort.Strings(emails)
+
+	return emails
+}
+
+func (api *fakeConsoleAPI) newID() string {
+	api.nextID++
+	return fmt.Sprintf("a0000000-0000-4000-8000-%012d", api.nextID)
+}
+
+func fakeClusterStatus(status console.ClusterComponentStatus) console.ClusterStatus {
+	component := func() *console.ClusterComponentStatus {
+		s := status
+		return &s
+	}
+
+	return console.ClusterStatus{
+		Ready:            status,
+		ZeebeStatus:      component(),
+		OperateStatus:    component(),
+		TasklistStatus:   component(),
+		OptimizeStatus:   component(),
+		ConnectorsStatus: component(),
+	}
+}
+
+// setFakeComponentStatus sets the status of a component, leaving it
+// unreported if the status is empty.
+func setFakeComponentStatus(status *console.ClusterStatus, component string, componentStatus console.ClusterComponentStatus) {
+	var value *console.ClusterComponentStatus
+	if componentStatus != "" {
+		value = &componentStatus
+	}
+
+	switch component {
+	case clusterComponentZeebe:
+		status.ZeebeStatus = value
+	case clusterComponentOperate:
+		status.OperateStatus = value
+	case clusterComponentTasklist:
+		status.TasklistStatus = value
+	case clusterComponentOptimize:
+		status.OptimizeStatus = value
+	case clusterComponentConnectors:
+		status.ConnectorsStatus = value
+	}
+}
+
+func (api *fakeConsoleAPI) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	// Cluster clients get their Zeebe tokens from the same endpoint.
+	if r.PostForm.Get("audience") == zeebeTokenAudience {
+		for _, clients := range api.clients {
+			if client, ok := clients[clientID]; ok && client.ClientSecret == clientSecret {
+				writeJSON(w, map[string]interface{}{
+					"access_token": "zeebe-token-" + clientID,
+					"token_type":   "Bearer",
+					"expires_in":   300,
+				})
+				return
+			}
+		}
+	} else if clientID == fakeClientID && clientSecret == fakeClientSecret {
+		writeJSON(w, map[string]interface{}{
+			"access_token": fakeAccessToken,
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusUnauthorized)
+	_, _ = w.Write([]byte(`{"error":"access_denied","error_description":"Unauthorized"}`))
+}
+
+func (api *fakeConsoleAPI) authorized(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+fakeAccessToken {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		api.mu.Lock()
+		defer api.mu.Unlock()
+
+		handler(w, r)
+	}
+}
+
+func (api *fakeConsoleAPI) getParameters(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, api.parameters)
+}
+
+func (api *fakeConsoleAPI) getClusters(w http.ResponseWriter, r *http.Request) {
+	clusters := []console.Cluster{}
+	for _, id := range sortedKeys(api.clusters) {
+		clusters = append(clusters, *api.refreshCluster(id))
+	}
+
+	writeJSON(w, clusters)
+}
+
+func (api *fakeConsoleAPI) createCluster(w http.ResponseWriter, r *http.Request) {
+	var body console.CreateClusterRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	channel := findChannel(&api.parameters, body.ChannelId)
+	if channel == nil || !channelAllowsGeneration(*channel, body.GenerationId) ||
+		!hasRegion(&api.parameters, body.RegionId) || !hasClusterPlanType(&api.parameters, body.PlanTypeId) {
+		http.Error(w, "invalid cluster parameters", http.StatusBadRequest)
+		return
+	}
+
+	id := api.newID()
+	zeebe := zeebeAddress(id, "bru-2")
+
+	api.clusters[id] = &console.Cluster{
+		Uuid:       id,
+		Name:       body.Name,
+		Created:    "2024-01-01T00:00:00Z",
+		PlanType:   console.ClusterPlanType{Uuid: body.PlanTypeId},
+		Channel:    console.ClusterChannel{Uuid: body.ChannelId, Name: channel.Name},
+		Region:     console.ClusterRegion{Uuid: body.RegionId},
+		Generation: console.ClusterGeneration{Uuid: body.GenerationId},
+		Status:     fakeClusterStatus(console.CLUSTERCOMPONENTSTATUS_CREATING),
+		Links:      console.ClusterLinks{Zeebe: &zeebe},
+		Stage:      body.Stage,
+		Labels:     body.Labels,
+	}
+	api.polls[id] = api.creatingPolls
+
+	writeJSON(w, console.CreateCluster200Response{ClusterId: id})
+}
+
+// refreshCluster moves a created cluster closer to being healthy.
+func (api *fakeConsoleAPI) refreshCluster(id string) *console.Cluster {
+	cluster := api.clusters[id]
+
+	if cluster.Status.Ready == console.CLUSTERCOMPONENTSTATUS_CREATING {
+		if api.polls[id] > 0 {
+			api.polls[id]--
+		} else {
+			cluster.Status = fakeClusterStatus(console.CLUSTERCOMPONENTSTATUS_HEALTHY)
+			for _, component := range api.stalledComponents {
+				setFakeComponentStatus(&cluster.Status, component, console.CLUSTERCOMPONENTSTATUS_CREATING)
+			}
+			for _, component := range api.unreportedComponents {
+				setFakeComponentStatus(&cluster.Status, component, "")
+			}
+		}
+	}
+
+	return cluster
+}
+
+func (api *fakeConsoleAPI) getCluster(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("clusterId")
+	if _, ok := api.clusters[id]; !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, api.refreshCluster(id))
+}
+
+func (api *fakeConsoleAPI) updateCluster(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := api.clusters[r.PathValue("clusterId")]
+	if !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	var body console.UpdateClusterRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if body.Stage != nil {
+		cluster.Stage = body.Stage
+	}
+	cluster.Labels = body.Labels
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *fakeConsoleAPI) deleteCluster(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("clusterId")
+	if _, ok := api.clusters[id]; !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	delete(api.clusters, id)
+	delete(api.polls, id)
+	delete(api.clients, id)
+	delete(api.secrets, id)
+	delete(api.backups, id)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *fakeConsoleAPI) updateIPWhitelist(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := api.clusters[r.PathValue("clusterId")]
+	if !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	var body console.IpWhiteListBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	cluster.Ipwhitelist = body.Ipwhitelist
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *fakeConsoleAPI) wakeCluster(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("clusterId")
+	cluster, ok := api.clusters[id]
+	if !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	if cluster.Status.Ready == console.CLUSTERCOMPONENTSTATUS_UNHEALTHY {
+		cluster.Status = fakeClusterStatus(console.CLUSTERCOMPONENTSTATUS_CREATING)
+		api.polls[id] = api.creatingPolls
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *fakeConsoleAPI) getBackups(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("clusterId")
+	if _, ok := api.clusters[clusterID]; !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	backups := []console.BackupDto{}
+	for _, backup := range api.backups[clusterID] {
+		if backup.BackupState == backupStateInProgress {
+			if api.polls[backup.BackupId] > 0 {
+				api.polls[backup.BackupId]--
+			} else {
+				backup.BackupState = backupStateCompleted
+			}
+		}
+		backups = append(backups, *backup)
+	}
+
+	writeJSON(w, backups)
+}
+
+func (api *fakeConsoleAPI) createBackup(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("clusterId")
+	if _, ok := api.clusters[clusterID]; !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	backupTime := "2024-01-01T00:00:00Z"
+	backup := &console.BackupDto{BackupId: api.newID(), BackupState: backupStateInProgress, BackupTime: &backupTime}
+	api.backups[clusterID] = append(api.backups[clusterID], backup)
+	api.polls[backup.BackupId] = api.creatingPolls
+
+	writeJSON(w, backup)
+}
+
+func (api *fakeConsoleAPI) deleteBackup(w http.ResponseWriter, r *http.Request) {
+	clusterID, backupID := r.PathValue("clusterId"), r.PathValue("backupId")
+
+	backups := api.backups[clusterID]
+	for i, backup := range backups {
+		if backup.BackupId == backupID {
+			api.backups[clusterID] = append(backups[:i], backups[i+1:]...)
+			delete(api.polls, backupID)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	}
+
+	http.Error(w, "backup not found", http.StatusNotFound)
+}
+
+func (api *fakeConsoleAPI) getClients(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("clusterId")
+	if _, ok := api.clusters[clusterID]; !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	clients := []console.ClusterClient{}
+	for _, clientID := range sortedKeys(api.clients[clusterID]) {
+		client := api.clients[clusterID][clientID]
+		clients = append(clients, console.ClusterClient{Name: client.Name, ClientId: client.ClientId})
+	}
+
+	writeJSON(w, clients)
+}
+
+func (api *fakeConsoleAPI) createClient(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("clusterId")
+	if _, ok := api.clusters[clusterID]; !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	var body console.CreateClusterClientBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	id := api.newID()
+	client := &console.CreatedClusterClient{
+		Name:         body.ClientName,
+		Uuid:         id,
+		ClientId:     "client-" + id[len(id)-4:],
+		ClientSecret: "secret-" + id,
+		Permissions:  body.Permissions,
+	}
+
+	if api.clients[clusterID] == nil {
+		api.clients[clusterID] = map[string]*console.CreatedClusterClient{}
+	}
+	api.clients[clusterID][client.ClientId] = client
+
+	writeJSON(w, client)
+}
+
+func (api *fakeConsoleAPI) getClient(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("clusterId")
+
+	client, ok := api.clients[clusterID][r.PathValue("clientId")]
+	if !ok {
+		http.Error(w, "client not found", http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, console.ClusterClientConnectionDetails{
+		Name:                           client.Name,
+		ZEEBE_ADDRESS:                  zeebeAddress(clusterID, "bru-2"),
+		ZEEBE_CLIENT_ID:                client.ClientId,
+		ZEEBE_AUTHORIZATION_SERVER_URL: api.server.URL + "/oauth/token",
+		Permissions:                    client.Permissions,
+	})
+}
+
+func (api *fakeConsoleAPI) deleteClient(w http.ResponseWriter, r *http.Request) {
+	clusterID, clientID := r.PathValue("clusterId"), r.PathValue("clientId")
+	if _, ok := api.clients[clusterID][clientID]; !ok {
+		http.Error(w, "client not found", http.StatusNotFound)
+		return
+	}
+
+	delete(api.clients[clusterID], clientID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *fakeConsoleAPI) getSecrets(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("clusterId")
+	if _, ok := api.clusters[clusterID]; !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	secrets := map[string]string{}
+	for name, value := range api.secrets[clusterID] {
+		secrets[name] = value
+	}
+
+	writeJSON(w, secrets)
+}
+
+func (api *fakeConsoleAPI) createSecret(w http.ResponseWriter, r *http.Request) {
+	clusterID := r.PathValue("clusterId")
+	if _, ok := api.clusters[clusterID]; !ok {
+		http.Error(w, "cluster not found", http.StatusNotFound)
+		return
+	}
+
+	var body console.CreateSecretBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, exists := api.secrets[clusterID][body.SecretName]; exists {
+		http.Error(w, "secret already exists", http.StatusConflict)
+		return
+	}
+
+	if api.secrets[clusterID] == nil {
+		api.secrets[clusterID] = map[string]string{}
+	}
+	api.secrets[clusterID][body.SecretName] = body.SecretValue
+
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (api *fakeConsoleAPI) updateSecret(w http.ResponseWriter, r *http.Request) {
+	clusterID, name := r.PathValue("clusterId"), r.PathValue("secretName")
+	if _, ok := api.secrets[clusterID][name]; !ok {
+		http.Error(w, "secret not found", http.StatusNotFound)
+		return
+	}
+
+	var body console.UpdateSecretBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	api.secrets[clusterID][name] = body.SecretValue
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *fakeConsoleAPI) deleteSecret(w http.ResponseWriter, r *http.Request) {
+	clusterID, name := r.PathValue("clusterId"), r.PathValue("secretName")
+	if _, ok := api.secrets[clusterID][name]; !ok {
+		http.Error(w, "secret not found", http.StatusNotFound)
+		return
+	}
+
+	delete(api.secrets[clusterID], name)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *fakeConsoleAPI) getMembers(w http.ResponseWriter, r *http.Request) {
+	members := []console.Member{}
+	for _, email := range sortedKeys(api.members) {
+		members = append(members, *api.members[email])
+	}
+
+	writeJSON(w, members)
+}
+
+func (api *fakeConsoleAPI) updateMember(w http.ResponseWriter, r *http.Request) {
+	email := r.PathValue("email")
+
+	var body struct {
+		OrgRoles []string `json:"orgRoles"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	member, ok := api.members[strings.ToLower(email)]
+	if !ok && api.pendingInvites {
+		// Invited members are only listed once they accepted the invitation.
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if !ok {
+		member = &console.Member{Email: email, Name: email}
+		api.members[strings.ToLower(email)] = member
+	}
+
+	member.Roles = nil
+	for _, role := range body.OrgRoles {
+		member.Roles = append(member.Roles, console.OrganizationRoleType(role))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (api *fakeConsoleAPI) deleteMember(w http.ResponseWriter, r *http.Request) {
+	email := strings.ToLower(r.PathValue("email"))
+
+	member, ok := api.members[email]
+	if !ok {
+		http.Error(w, "member not found", http.StatusNotFound)
+		return
+	}
+
+	if memberHasRole(*member, organizationRoleOwner) {
+		http.Error(w, "the owner cannot be removed", http.StatusForbidden)
+		return
+	}
+
+	delete(api.members, email)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, strings.TrimSpace(err.Error()), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// testRun runs f with args and returns its result, or the error it reports.
+func testRun(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	definitionResp := function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, &definitionResp)
+	if definitionResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected definition diagnostics: %v", definitionResp.Diagnostics)
+	}
+
+	returnType := definitionResp.Definition.Return.GetType()
+	unknown, err := returnType.ValueFromTerraform(ctx, tftypes.NewValue(returnType.TerraformType(ctx), tftypes.UnknownValue))
+	if err != nil {
+		t.Fatalf("unable to build result: %s", err)
+	}
+
+	resp := function.RunResponse{Result: function.NewResultData(unknown)}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseGenerationFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name   string
+		expect parseGenerationResult
+		error  bool
+	}{
+		"patch version": {
+			name: "Zeebe 8.6.3",
+			expect: parseGenerationResult{
+				Version: types.StringValue("8.6.3"),
+				Major:   types.Int64Value(8),
+				Minor:   types.Int64Value(6),
+				Patch:   types.Int64Value(3),
+			},
+		},
+		"minor version": {
+			name: "Camunda 8.7+gen2",
+			expect: parseGenerationResult{
+				Version: types.StringValue("8.7"),
+				Major:   types.Int64Value(8),
+				Minor:   types.Int64Value(7),
+				Patch:   types.Int64Null(),
+			},
+		},
+		"no version": {
+			name:  "Zeebe Alpha",
+			error: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, funcErr := testRun(t, &ParseGenerationFunction{}, types.StringValue(testCase.name))
+			if testCase.error {
+				if funcErr == nil {
+					t.Errorf("expected an error, got %v", value)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("unexpected error: %s", funcErr)
+			}
+
+			var got parseGenerationResult
+			if diags := value.(types.Object).As(context.Background(), &got, basetypes.ObjectAsOptions{}); diags.HasError() {
+				t.Fatalf("unable to read result: %v", diags)
+			}
+
+			if got != testCase.expect {
+				t.Errorf("expected %v, got %v", testCase.expect, got)
+			}
+		})
+	}
+}
+
+func TestZeebeAddressFunction(t *testing.T) {
+	t.Parallel()
+
+	value, funcErr := testRun(t, &ZeebeAddressFunction{}, types.StringValue("cluster-1"), types.StringValue("bru-2"))
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+
+	if expect := types.StringValue("cluster-1.bru-2.zeebe.camunda.io:443"); !value.Equal(expect) {
+		t.Errorf("expected %s, got %s", expect, value)
+	}
+
+	if _, funcErr := testRun(t, &ZeebeAddressFunction{}, types.StringValue("cluster-1"), types.StringValue("")); funcErr == nil || *funcErr.FunctionArgument != 1 {
+		t.Errorf("expected an error on the zone, got %v", funcErr)
+	}
+}
+
+func TestAccZeebeAddressFunction(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The zone of the region data source builds the address
+			{
+				Config: api.providerConfig() + `
+data "camunda_region" "test" {
+  key = "europe-west1"
+}
+
+output "zeebe_address" {
+  value = provider::camunda::zeebe_address("cluster-1", data.camunda_region.test.zone)
+}
+`,
+				Check: resource.TestCheckOutput("zeebe_address", "cluster-1.bru-2.zeebe.camunda.io:443"),
+			},
+		},
+	})
+}
+
+func TestCIDRNormalizeFunction(t *testing.T) {
+	t.Parallel()
+
+	value, funcErr := testRun(t, &CIDRNormalizeFunction{}, types.StringValue("10.0.0.12/24"))
+	if funcErr != nil {
+		t.Fatalf("unexpected error: %s", funcErr)
+	}
+
+	if expect := types.StringValue("10.0.0.0/24"); !value.Equal(expect) {
+		t.Errorf("expected %s, got %s", expect, value)
+	}
+
+	if _, funcErr := testRun(t, &CIDRNormalizeFunction{}, types.StringValue("foobar")); funcErr == nil {
+		t.Errorf("expected an error")
+	}
+}
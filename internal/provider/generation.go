@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"regexp"
+	"strconv"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// generationVersion matches the version in generation names such as
+// `Zeebe 8.6.3` or `Camunda 8.7+gen2`.
+var generationVersion = regexp.MustCompile(`([0-9]+)\.([0-9]+)(?:\.([0-9]+))?`)
+
+type generationModel struct {
+	Id      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+	Major   types.Int64  `tfsdk:"major"`
+	Minor   types.Int64  `tfsdk:"minor"`
+	Patch   types.Int64  `tfsdk:"patch"`
+}
+
+type generationVersionParts struct {
+	version string
+	major   int64
+	minor   int64
+	patch   *int64
+}
+
+// parseGenerationVersion extracts the version from a generation name. The
+// patch is nil when the name only carries the minor version.
+func parseGenerationVersion(name string) (generationVersionParts, bool) {
+	match := generationVersion.FindStringSubmatch(name)
+	if match == nil {
+		return generationVersionParts{}, false
+	}
+
+	parts := generationVersionParts{version: match[0]}
+	parts.major, _ = strconv.ParseInt(match[1], 10, 64)
+	parts.minor, _ = strconv.ParseInt(match[2], 10, 64)
+
+	if match[3] != "" {
+		patch, _ := strconv.ParseInt(match[3], 10, 64)
+		parts.patch = &patch
+	}
+
+	return parts, true
+}
+
+func newGenerationModel(generation console.GenerationsInner) generationModel {
+	model := generationModel{
+		Id:      types.StringValue(generation.Uuid),
+		Name:    types.StringValue(generation.Name),
+		Version: types.StringNull(),
+		Major:   types.Int64Null(),
+		Minor:   types.Int64Null(),
+		Patch:   types.Int64Null(),
+	}
+
+	parts, ok := parseGenerationVersion(generation.Name)
+	if !ok {
+		return model
+	}
+
+	model.Version = types.StringValue(parts.version)
+	model.Major = types.Int64Value(parts.major)
+	model.Minor = types.Int64Value(parts.minor)
+	model.Patch = types.Int64PointerValue(parts.patch)
+
+	return model
+}
+
+// generationSchemaAttributes describes a generation in data source schemas.
+func generationSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the generation",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the generation",
+			Computed:            true,
+		},
+		"version": schema.StringAttribute{
+			MarkdownDescription: "The version of the generation, such as `8.6.3`, parsed from its name. Null when the name carries no version.",
+			Computed:            true,
+		},
+		"major": schema.Int64Attribute{
+			MarkdownDescription: "The major version of the generation",
+			Computed:            true,
+		},
+		"minor": schema.Int64Attribute{
+			MarkdownDescription: "The minor version of the generation",
+			Computed:            true,
+		},
+		"patch": schema.Int64Attribute{
+			MarkdownDescription: "The patch version of the generation. Null when the name only carries the minor version.",
+			Computed:            true,
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseGenerationVersion(t *testing.T) {
+	t.Parallel()
+
+	patch := func(v int64) *int64 { return &v }
+
+	testCases := map[string]struct {
+		name   string
+		ok     bool
+		expect generationVersionParts
+	}{
+		"full version": {
+			name:   "Zeebe 8.6.3",
+			ok:     true,
+			expect: generationVersionParts{version: "8.6.3", major: 8, minor: 6, patch: patch(3)},
+		},
+		"build suffix": {
+			name:   "Camunda 8.7+gen2",
+			ok:     true,
+			expect: generationVersionParts{version: "8.7", major: 8, minor: 7},
+		},
+		"alpha": {
+			name:   "Camunda 8.8.0-alpha4",
+			ok:     true,
+			expect: generationVersionParts{version: "8.8.0", major: 8, minor: 8, patch: patch(0)},
+		},
+		"no version": {
+			name: "Latest",
+			ok:   false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			parts, ok := parseGenerationVersion(testCase.name)
+			if ok != testCase.ok {
+				t.Fatalf("expected ok=%v, got %v", testCase.ok, ok)
+			}
+
+			if parts.version != testCase.expect.version || parts.major != testCase.expect.major || parts.minor != testCase.expect.minor {
+				t.Errorf("expected %+v, got %+v", testCase.expect, parts)
+			}
+
+			if (parts.patch == nil) != (testCase.expect.patch == nil) ||
+				(parts.patch != nil && *parts.patch != *testCase.expect.patch) {
+				t.Errorf("unexpected patch: %v", parts.patch)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// splitImportID splits the identifier of resources nested in a cluster, such
+// as `<cluster_id>/<name>`, reporting an error when it does not match format.
+func splitImportID(req resource.ImportStateRequest, resp *resource.ImportStateResponse, format string) ([]string, bool) {
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != strings.Count(format, "/")+1 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", format, req.ID),
+		)
+		return nil, false
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: %s. Got: %q", format, req.ID),
+			)
+			return nil, false
+		}
+	}
+
+	return parts, true
+}
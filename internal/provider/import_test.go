@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestImportState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource resource.Resource
+		id       string
+		identity interface{}
+		expect   map[string]string
+		error    bool
+	}{
+		"cluster by id": {
+			resource: &CamundaClusterResource{},
+			id:       "cluster-1",
+			expect:   map[string]string{"id": "cluster-1"},
+		},
+		"cluster by identity": {
+			resource: &CamundaClusterResource{},
+			identity: camundaClusterIdentity{Id: types.StringValue("cluster-1")},
+			expect:   map[string]string{"id": "cluster-1"},
+		},
+		"cluster backup by id": {
+			resource: &CamundaClusterBackupResource{},
+			id:       "cluster-1/backup-1",
+			expect:   map[string]string{"id": "backup-1", "cluster_id": "cluster-1"},
+		},
+		"cluster backup by identity": {
+			resource: &CamundaClusterBackupResource{},
+			identity: camundaClusterBackupIdentity{ClusterId: types.StringValue("cluster-1"), BackupId: types.StringValue("backup-1")},
+			expect:   map[string]string{"id": "backup-1", "cluster_id": "cluster-1"},
+		},
+		"cluster client by id": {
+			resource: &CamundaClusterClientResource{},
+			id:       "cluster-1/client-1",
+			expect:   map[string]string{"id": "client-1", "cluster_id": "cluster-1", "zeebe_client_id": "client-1"},
+		},
+		"cluster client by identity": {
+			resource: &CamundaClusterClientResource{},
+			identity: camundaClusterClientIdentity{ClusterId: types.StringValue("cluster-1"), ClientId: types.StringValue("client-1")},
+			expect:   map[string]string{"id": "client-1", "cluster_id": "cluster-1", "zeebe_client_id": "client-1"},
+		},
+		"cluster client by invalid id": {
+			resource: &CamundaClusterClientResource{},
+			id:       "client-1",
+			error:    true,
+		},
+		"connector secret by id": {
+			resource: &CamundaClusterConnectorSecretResource{},
+			id:       "cluster-1/API_KEY",
+			expect:   map[string]string{"cluster_id": "cluster-1", "name": "API_KEY"},
+		},
+		"connector secret by identity": {
+			resource: &CamundaClusterConnectorSecretResource{},
+			identity: camundaClusterConnectorSecretIdentity{ClusterId: types.StringValue("cluster-1"), Name: types.StringValue("API_KEY")},
+			expect:   map[string]string{"cluster_id": "cluster-1", "name": "API_KEY"},
+		},
+		"connector secret by invalid id": {
+			resource: &CamundaClusterConnectorSecretResource{},
+			id:       "cluster-1/",
+			error:    true,
+		},
+		"ip whitelist by id": {
+			resource: &CamundaClusterIPWhiteListResource{},
+			id:       "cluster-1",
+			expect:   map[string]string{"id": "cluster-1", "cluster_id": "cluster-1"},
+		},
+		"ip whitelist by identity": {
+			resource: &CamundaClusterIPWhiteListResource{},
+			identity: camundaClusterIPWhitelistIdentity{ClusterID: types.StringValue("cluster-1")},
+			expect:   map[string]string{"id": "cluster-1", "cluster_id": "cluster-1"},
+		},
+		"organization member by id": {
+			resource: &CamundaOrganizationMemberResource{},
+			id:       "jane@example.org",
+			expect:   map[string]string{"email": "jane@example.org"},
+		},
+		"organization member by identity": {
+			resource: &CamundaOrganizationMemberResource{},
+			identity: camundaOrganizationMemberIdentity{Email: types.StringValue("jane@example.org")},
+			expect:   map[string]string{"email": "jane@example.org"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := testCase.resource.(resource.ResourceWithImportState)
+
+			req := resource.ImportStateRequest{ID: testCase.id}
+			if testCase.identity != nil {
+				req.Identity = testResourceIdentity(t, r)
+				if diags := req.Identity.Set(ctx, testCase.identity); diags.HasError() {
+					t.Fatalf("unable to build identity: %v", diags)
+				}
+			}
+
+			resp := resource.ImportStateResponse{
+				State:    emptyState(testResourceSchema(t, r)),
+				Identity: testResourceIdentity(t, r),
+			}
+			r.ImportState(ctx, req, &resp)
+
+			if testCase.error {
+				if !resp.Diagnostics.HasError() {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected import diagnostics: %v", resp.Diagnostics)
+			}
+
+			for attribute, expect := range testCase.expect {
+				var value types.String
+				resp.State.GetAttribute(ctx, path.Root(attribute), &value)
+
+				if value.ValueString() != expect {
+					t.Errorf("expected %s to be %q, got %q", attribute, expect, value.ValueString())
+				}
+			}
+		})
+	}
+}
+
+func TestReadSetsIdentity(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	api.addCluster("cluster-1")
+
+	r := &CamundaClusterIPWhiteListResource{provider: api.provider()}
+	s := testResourceSchema(t, r)
+
+	state := stateFrom(t, s, camundaClusterIPWhitelistData{
+		Id:        types.StringValue("cluster-1"),
+		ClusterID: types.StringValue("cluster-1"),
+	})
+
+	// States written by previous versions of the provider have no identity.
+	resp := resource.ReadResponse{State: state, Identity: testResourceIdentity(t, r)}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", resp.Diagnostics)
+	}
+
+	var identity camundaClusterIPWhitelistIdentity
+	if diags := resp.Identity.Get(ctx, &identity); diags.HasError() {
+		t.Fatalf("unable to read identity: %v", diags)
+	}
+
+	if identity.ClusterID.ValueString() != "cluster-1" {
+		t.Errorf("expected the identity cluster_id to be cluster-1, got %q", identity.ClusterID.ValueString())
+	}
+}
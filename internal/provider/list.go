@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// configureListResource returns the provider passed to the Configure method of
+// list resources, or nil if the provider is not configured yet.
+func configureListResource(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *CamundaCloudProvider {
+	// Provider not yet configured
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	provider, ok := req.ProviderData.(*CamundaCloudProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *CamundaCloudProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return nil
+	}
+
+	return provider
+}
+
+// listResults streams a result per item, built by result, stopping once the
+// limit of the request is reached.
+func listResults[T any](ctx context.Context, req list.ListRequest, items []T, result func(T, *list.ListResult)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			r := req.NewListResult(ctx)
+			result(item, &r)
+
+			if !push(r) {
+				return
+			}
+		}
+	}
+}
+
+// listError streams a single result reporting a client error.
+func listError(summary string, detail string) iter.Seq[list.ListResult] {
+	var diags diag.Diagnostics
+	diags.AddError(summary, detail)
+
+	return list.ListResultsStreamDiagnostics(diags)
+}
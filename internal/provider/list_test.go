@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testList lists the instances of r with lr, configured with config, or with
+// an empty configuration if nil for list resources without attributes, and
+// returns the results.
+func testList(t *testing.T, p *CamundaCloudProvider, lr list.ListResource, r resource.Resource, config interface{}, includeResource bool) []list.ListResult {
+	t.Helper()
+
+	ctx := context.Background()
+
+	configureResp := resource.ConfigureResponse{}
+	lr.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: p}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", configureResp.Diagnostics)
+	}
+
+	schemaResp := list.ListResourceSchemaResponse{}
+	lr.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if config == nil {
+		plan.Raw = tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{})
+	} else if diags := plan.Set(ctx, config); diags.HasError() {
+		t.Fatalf("unable to build config: %v", diags)
+	}
+
+	req := list.ListRequest{
+		Config:                 tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw},
+		IncludeResource:        includeResource,
+		ResourceSchema:         testResourceSchema(t, r),
+		ResourceIdentitySchema: testResourceIdentity(t, r).Schema,
+	}
+
+	stream := list.ListResultsStream{}
+	lr.List(ctx, req, &stream)
+
+	var results []list.ListResult
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("unexpected list diagnostics: %v", result.Diagnostics)
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func TestCamundaClusterListResource(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	api.addCluster("cluster-1")
+	api.addCluster("cluster-2")
+
+	results := testList(t, api.provider(), &CamundaClusterListResource{}, &CamundaClusterResource{}, nil, true)
+
+	var ids []string
+	for _, result := range results {
+		var identity camundaClusterIdentity
+		result.Identity.Get(ctx, &identity)
+
+		var data camundaClusterData
+		result.Resource.Get(ctx, &data)
+
+		if data.Id != identity.Id || data.Name.ValueString() != result.DisplayName {
+			t.Errorf("unexpected result %q: identity %v, resource %v", result.DisplayName, identity, data)
+		}
+		ids = append(ids, identity.Id.ValueString())
+	}
+
+	if !reflect.DeepEqual(ids, []string{"cluster-1", "cluster-2"}) {
+		t.Errorf("expected both clusters to be listed, got %v", ids)
+	}
+}
+
+func TestCamundaClusterClientListResource(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	api.addCluster("cluster-1")
+	api.addCluster("cluster-2")
+	api.addClient("cluster-1", "worker")
+	api.addClient("cluster-2", "operator")
+
+	for name, testCase := range map[string]struct {
+		config interface{}
+		expect []string
+	}{
+		"all clusters": {
+			config: camundaClusterClientListConfig{ClusterId: types.StringNull()},
+			expect: []string{"cluster-1/worker", "cluster-2/operator"},
+		},
+		"one cluster": {
+			config: camundaClusterClientListConfig{ClusterId: types.StringValue("cluster-2")},
+			expect: []string{"cluster-2/operator"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			results := testList(t, api.provider(), &CamundaClusterClientListResource{}, &CamundaClusterClientResource{}, testCase.config, true)
+
+			var got []string
+			for _, result := range results {
+				var identity camundaClusterClientIdentity
+				result.Identity.Get(ctx, &identity)
+
+				var data camundaClusterClientData
+				result.Resource.Get(ctx, &data)
+
+				if data.ZeebeClientId != identity.ClientId || data.ZeebeAddress.ValueString() == "" || !data.Secret.IsNull() {
+					t.Errorf("unexpected result %q: identity %v, resource %v", result.DisplayName, identity, data)
+				}
+				got = append(got, identity.ClusterId.ValueString()+"/"+result.DisplayName)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expect) {
+				t.Errorf("expected %v, got %v", testCase.expect, got)
+			}
+		})
+	}
+}
+
+func TestCamundaClusterConnectorSecretListResource(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	api.addCluster("cluster-1")
+	api.addSecret("cluster-1", "API_KEY", "s3cr3t")
+	api.addSecret("cluster-1", "TOKEN", "s3cr3t")
+
+	results := testList(t, api.provider(), &CamundaClusterConnectorSecretListResource{}, &CamundaClusterConnectorSecretResource{},
+		camundaClusterConnectorSecretListConfig{ClusterId: types.StringNull()}, true)
+
+	var names []string
+	for _, result := range results {
+		var data camundaClusterConnectorSecret
+		result.Resource.Get(ctx, &data)
+
+		if !data.Value.IsNull() {
+			t.Errorf("expected the value of %s not to be listed", result.DisplayName)
+		}
+		names = append(names, data.Name.ValueString())
+	}
+
+	if !reflect.DeepEqual(names, []string{"API_KEY", "TOKEN"}) {
+		t.Errorf("expected both secrets to be listed, got %v", names)
+	}
+}
+
+func TestCamundaOrganizationMemberListResource(t *testing.T) {
+	ctx := context.Background()
+	api := newFakeConsoleAPI(t)
+	api.addMember("jane@example.org", "developer")
+
+	results := testList(t, api.provider(), &CamundaOrganizationMemberListResource{}, &CamundaOrganizationMemberResource{}, nil, false)
+
+	var emails []string
+	for _, result := range results {
+		var identity camundaOrganizationMemberIdentity
+		result.Identity.Get(ctx, &identity)
+		emails = append(emails, identity.Email.ValueString())
+
+		if !result.Resource.Raw.IsNull() {
+			t.Errorf("expected the resource of %s not to be included", result.DisplayName)
+		}
+	}
+
+	if !reflect.DeepEqual(emails, []string{"jane@example.org", fakeOwnerEmail}) {
+		t.Errorf("expected every member to be listed, got %v", emails)
+	}
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// The member has been invited but did not accept the invitation yet.
+	memberStatusInvited = "invited"
+	// The member accepted the invitation and is part of the organization.
+	memberStatusActive = "active"
+
+	organizationRoleAdmin = string(console.ORGANIZATIONROLEADMIN_ADMIN)
+	// The owner role is held by the creator of the organization and cannot be assigned.
+	organizationRoleOwner = "owner"
+)
+
+// assignableOrganizationRoles lists the roles that can be given to members.
+var assignableOrganizationRoles = []string{
+	string(console.ORGANIZATIONROLEADMIN_ADMIN),
+	string(console.ORGANIZATIONROLEANALYST_ANALYST),
+	string(console.ORGANIZATIONROLEDEVELOPER_DEVELOPER),
+	string(console.ORGANIZATIONROLEOPERATIONSENGINEER_OPERATIONSENGINEER),
+	string(console.ORGANIZATIONROLETASKUSER_TASKUSER),
+	string(console.ORGANIZATIONROLEVISITOR_VISITOR),
+}
+
+// assignableOrganizationRole converts a role name into the type expected by
+// the member API.
+func assignableOrganizationRole(name string) (console.AssignableOrganizationRoleType, error) {
+	switch name {
+	case string(console.ORGANIZATIONROLEADMIN_ADMIN):
+		role := console.ORGANIZATIONROLEADMIN_ADMIN
+		return console.OrganizationRoleAdminAsAssignableOrganizationRoleType(&role), nil
+	case string(console.ORGANIZATIONROLEANALYST_ANALYST):
+		role := console.ORGANIZATIONROLEANALYST_ANALYST
+		return console.OrganizationRoleAnalystAsAssignableOrganizationRoleType(&role), nil
+	case string(console.ORGANIZATIONROLEDEVELOPER_DEVELOPER):
+		role := console.ORGANIZATIONROLEDEVELOPER_DEVELOPER
+		return console.OrganizationRoleDeveloperAsAssignableOrganizationRoleType(&role), nil
+	case string(console.ORGANIZATIONROLEOPERATIONSENGINEER_OPERATIONSENGINEER):
+		role := console.ORGANIZATIONROLEOPERATIONSENGINEER_OPERATIONSENGINEER
+		return console.OrganizationRoleOperationsEngineerAsAssignableOrganizationRoleType(&role), nil
+	case string(console.ORGANIZATIONROLETASKUSER_TASKUSER):
+		role := console.ORGANIZATIONROLETASKUSER_TASKUSER
+		return console.OrganizationRoleTaskUserAsAssignableOrganizationRoleType(&role), nil
+	case string(console.ORGANIZATIONROLEVISITOR_VISITOR):
+		role := console.ORGANIZATIONROLEVISITOR_VISITOR
+		return console.OrganizationRoleVisitorAsAssignableOrganizationRoleType(&role), nil
+	}
+
+	return console.AssignableOrganizationRoleType{}, fmt.Errorf("unknown organization role '%s', must be one of: %s",
+		name, strings.Join(assignableOrganizationRoles, ", "))
+}
+
+// findMember looks up a member by email. Emails are matched case-insensitively
+// as the Console API does not preserve the case they were invited with.
+func findMember(members []console.Member, email string) *console.Member {
+	for i := range members {
+		if strings.EqualFold(members[i].Email, email) {
+			return &members[i]
+		}
+	}
+
+	return nil
+}
+
+// memberRoles returns the names of all the roles of a member.
+func memberRoles(member console.Member) []string {
+	roles := make([]string, 0, len(member.Roles))
+	for _, role := range member.Roles {
+		roles = append(roles, string(role))
+	}
+
+	return roles
+}
+
+// assignableMemberRoles returns the roles of a member that can be managed,
+// leaving out the owner role.
+func assignableMemberRoles(member console.Member) []string {
+	roles := []string{}
+	for _, role := range memberRoles(member) {
+		if role != organizationRoleOwner {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
+
+func memberHasRole(member console.Member, role string) bool {
+	for _, r := range memberRoles(member) {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func setHasRole(roles types.Set, role string) bool {
+	for _, r := range roles.Elements() {
+		if s, ok := r.(types.String); ok && s.ValueString() == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lastAdminRemovalReason explains why removing the given member, or its admin
+// role, would lock the organization out. It is empty when the removal is safe.
+func lastAdminRemovalReason(members []console.Member, email string) string {
+	member := findMember(members, email)
+	if member == nil {
+		return ""
+	}
+
+	if memberHasRole(*member, organizationRoleOwner) {
+		return "is the owner of the organization"
+	}
+
+	if !memberHasRole(*member, organizationRoleAdmin) {
+		return ""
+	}
+
+	for _, other := range members {
+		if other.Email != member.Email && (memberHasRole(other, organizationRoleAdmin) || memberHasRole(other, organizationRoleOwner)) {
+			return ""
+		}
+	}
+
+	return "is the last admin of the organization"
+}
+
+// memberStatus tells whether the given email is an active member of the
+// organization, or whether its invitation is still pending.
+func memberStatus(ctx context.Context, client console.APIClient, email string) (string, error) {
+	members, _, err := client.DefaultAPI.GetMembers(ctx).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	if findMember(members, email) != nil {
+		return memberStatusActive, nil
+	}
+
+	return memberStatusInvited, nil
+}
+
+func setMember(ctx context.Context, client console.APIClient, email string, roles []string) error {
+	orgRoles := make([]console.AssignableOrganizationRoleType, 0, len(roles))
+
+	for _, name := range roles {
+		role, err := assignableOrganizationRole(name)
+		if err != nil {
+			return err
+		}
+
+		orgRoles = append(orgRoles, role)
+	}
+
+	body := console.PostMemberBody{
+		OrgRoles: orgRoles,
+	}
+
+	_, err := client.DefaultAPI.UpdateMembers(ctx, email).
+		PostMemberBody(body).
+		Execute()
+
+	if err != nil {
+		return fmt.Errorf("error while calling the update member API: %w", err)
+	}
+
+	return nil
+}
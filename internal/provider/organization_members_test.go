@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+)
+
+func TestAssignableOrganizationRole(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range assignableOrganizationRoles {
+		name := name
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			role, err := assignableOrganizationRole(name)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			encoded, err := json.Marshal(role)
+			if err != nil {
+				t.Fatalf("unable to encode role: %s", err)
+			}
+
+			if string(encoded) != `"`+name+`"` {
+				t.Errorf("expected role to be sent as %q, got %s", name, encoded)
+			}
+		})
+	}
+
+	if _, err := assignableOrganizationRole(organizationRoleOwner); err == nil {
+		t.Errorf("the owner role must not be assignable")
+	}
+}
+
+func TestFindMemberIgnoresCase(t *testing.T) {
+	t.Parallel()
+
+	members := []console.Member{
+		{Email: "Jane.Doe@Example.org"},
+	}
+
+	if findMember(members, "jane.doe@example.org") == nil {
+		t.Errorf("expected member to be found regardless of the email case")
+	}
+
+	if findMember(members, "john.doe@example.org") != nil {
+		t.Errorf("expected unknown member not to be found")
+	}
+}
+
+func TestLastAdminRemovalReason(t *testing.T) {
+	t.Parallel()
+
+	member := func(email string, roles ...console.OrganizationRoleType) console.Member {
+		return console.Member{Email: email, Roles: roles}
+	}
+
+	testCases := map[string]struct {
+		members     []console.Member
+		email       string
+		expectBlock bool
+	}{
+		"owner": {
+			members:     []console.Member{member("owner@example.org", "owner"), member("admin@example.org", "admin")},
+			email:       "owner@example.org",
+			expectBlock: true,
+		},
+		"last admin": {
+			members:     []console.Member{member("admin@example.org", "admin"), member("dev@example.org", "developer")},
+			email:       "ADMIN@example.org",
+			expectBlock: true,
+		},
+		"one of several admins": {
+			members:     []console.Member{member("admin@example.org", "admin"), member("other@example.org", "admin")},
+			email:       "admin@example.org",
+			expectBlock: false,
+		},
+		"admin next to the owner": {
+			members:     []console.Member{member("admin@example.org", "admin"), member("owner@example.org", "owner")},
+			email:       "admin@example.org",
+			expectBlock: false,
+		},
+		"not an admin": {
+			members:     []console.Member{member("admin@example.org", "admin"), member("dev@example.org", "developer")},
+			email:       "dev@example.org",
+			expectBlock: false,
+		},
+		"pending invitation": {
+			members:     []console.Member{member("admin@example.org", "admin")},
+			email:       "new@example.org",
+			expectBlock: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			reason := lastAdminRemovalReason(testCase.members, testCase.email)
+			if (reason != "") != testCase.expectBlock {
+				t.Errorf("expected removal to be blocked: %v, got reason %q", testCase.expectBlock, reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+)
+
+// parametersCacheTTL is how long the parameters (channels, regions and cluster
+// plan types) fetched from the Console API are reused before being refreshed.
+const parametersCacheTTL = 5 * time.Minute
+
+// parametersCache shares the result of GetParameters between all the data
+// sources and resources of a provider instance. Concurrent lookups wait for a
+// single in-flight request instead of each calling the API.
+type parametersCache struct {
+	mu        sync.Mutex
+	ttl       time.Duration
+	now       func() time.Time
+	params    *console.Parameters
+	fetchedAt time.Time
+}
+
+func newParametersCache(ttl time.Duration) *parametersCache {
+	return &parametersCache{
+		ttl: ttl,
+		now: time.Now,
+	}
+}
+
+// get returns the cached parameters, calling fetch if they were never fetched
+// or are older than the TTL. Errors are not cached.
+func (c *parametersCache) get(ctx context.Context, fetch func(context.Context) (*console.Parameters, error)) (*console.Parameters, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.params != nil && c.now().Sub(c.fetchedAt) < c.ttl {
+		return c.params, nil
+	}
+
+	params, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.params = params
+	c.fetchedAt = c.now()
+
+	return params, nil
+}
+
+// parameters returns the channels, regions and cluster plan types available to
+// the organization, fetched at most once per TTL.
+func (p *CamundaCloudProvider) parameters(ctx context.Context) (*console.Parameters, error) {
+	return p.parametersCache.get(ctx, func(ctx context.Context) (*console.Parameters, error) {
+		ctx = context.WithValue(ctx, console.ContextAccessToken, p.accessToken)
+		params, _, err := p.client.DefaultAPI.GetParameters(ctx).Execute()
+
+		return params, err
+	})
+}
+
+func hasRegion(params *console.Parameters, id string) bool {
+	for _, region := range params.Regions {
+		if region.Uuid == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasClusterPlanType(params *console.Parameters, id string) bool {
+	for _, planType := range params.ClusterPlanTypes {
+		if planType.Uuid == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func findChannel(params *console.Parameters, id string) *console.ParametersChannelsInner {
+	for i := range params.Channels {
+		if params.Channels[i].Uuid == id {
+			return &params.Channels[i]
+		}
+	}
+
+	return nil
+}
+
+func channelAllowsGeneration(channel console.ParametersChannelsInner, id string) bool {
+	if channel.DefaultGeneration.Uuid == id {
+		return true
+	}
+
+	for _, generation := range channel.AllowedGenerations {
+		if generation.Uuid == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	cloudProviderAWS = "aws"
+	cloudProviderGCP = "gcp"
+)
+
+var (
+	// AWS region keys look like `eu-central-1`, GCP ones like `europe-west1`.
+	awsRegionKey = regexp.MustCompile(`^[a-z]{2}(-gov)?-[a-z]+-[0-9]+$`)
+	gcpRegionKey = regexp.MustCompile(`^[a-z]+-[a-z]+[0-9]+$`)
+)
+
+// regionCloudProvider tells which cloud provider hosts a region, based on the
+// format of its key. It is empty when the provider cannot be recognized.
+func regionCloudProvider(region console.ParametersRegionsInner) string {
+	switch {
+	case awsRegionKey.MatchString(region.Region):
+		return cloudProviderAWS
+	case gcpRegionKey.MatchString(region.Region):
+		return cloudProviderGCP
+	}
+
+	return ""
+}
+
+// validNames formats the given names for error messages listing the valid values.
+func validNames(names []string) string {
+	sorted := append([]string{}, names...)
+	sort.Strings(sorted)
+
+	return "'" + strings.Join(sorted, "', '") + "'"
+}
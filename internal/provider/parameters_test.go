@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+)
+
+func TestParametersCacheSingleFetch(t *testing.T) {
+	t.Parallel()
+
+	cache := newParametersCache(time.Minute)
+
+	var calls int32
+	fetch := func(context.Context) (*console.Parameters, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		return &console.Parameters{}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := cache.get(context.Background(), fetch); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("expected parameters to be fetched once, got %d calls", calls)
+	}
+}
+
+func TestParametersCacheTTL(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	cache := newParametersCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	calls := 0
+	fetch := func(context.Context) (*console.Parameters, error) {
+		calls++
+		return &console.Parameters{}, nil
+	}
+
+	cache.get(context.Background(), fetch)
+	now = now.Add(30 * time.Second)
+	cache.get(context.Background(), fetch)
+
+	if calls != 1 {
+		t.Errorf("expected cached parameters to be reused within the TTL, got %d calls", calls)
+	}
+
+	now = now.Add(time.Minute)
+	cache.get(context.Background(), fetch)
+
+	if calls != 2 {
+		t.Errorf("expected parameters to be fetched again after the TTL, got %d calls", calls)
+	}
+}
+
+func TestParametersCacheDoesNotCacheErrors(t *testing.T) {
+	t.Parallel()
+
+	cache := newParametersCache(time.Minute)
+
+	calls := 0
+	fetch := func(context.Context) (*console.Parameters, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("unavailable")
+		}
+		return &console.Parameters{}, nil
+	}
+
+	if _, err := cache.get(context.Background(), fetch); err == nil {
+		t.Fatalf("expected the first lookup to fail")
+	}
+
+	if _, err := cache.get(context.Background(), fetch); err != nil {
+		t.Fatalf("expected the second lookup to succeed, got: %s", err)
+	}
+}
+
+func TestRegionCloudProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"europe-west1":         cloudProviderGCP,
+		"australia-southeast1": cloudProviderGCP,
+		"us-east1":             cloudProviderGCP,
+		"eu-central-1":         cloudProviderAWS,
+		"us-east-1":            cloudProviderAWS,
+		"us-gov-west-1":        cloudProviderAWS,
+		"bru-2":                "",
+		"":                     "",
+	}
+
+	for key, expected := range testCases {
+		if got := regionCloudProvider(console.ParametersRegionsInner{Region: key}); got != expected {
+			t.Errorf("expected region '%s' to be hosted by %q, got %q", key, expected, got)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ParseGenerationFunction{}
+
+type parseGenerationResult struct {
+	Version types.String `tfsdk:"version"`
+	Major   types.Int64  `tfsdk:"major"`
+	Minor   types.Int64  `tfsdk:"minor"`
+	Patch   types.Int64  `tfsdk:"patch"`
+}
+
+type ParseGenerationFunction struct{}
+
+func NewParseGenerationFunction() function.Function {
+	return &ParseGenerationFunction{}
+}
+
+func (f *ParseGenerationFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_generation"
+}
+
+func (f *ParseGenerationFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Parse the version of a generation name",
+		MarkdownDescription: "Parses the version of a generation name, such as `Zeebe 8.6.3` or `Camunda 8.7+gen2`, into an object with the `version`, `major`, `minor` and `patch` attributes. The `patch` is null when the name only carries the minor version.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The name of the generation",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"version": types.StringType,
+				"major":   types.Int64Type,
+				"minor":   types.Int64Type,
+				"patch":   types.Int64Type,
+			},
+		},
+	}
+}
+
+func (f *ParseGenerationFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	parts, ok := parseGenerationVersion(name)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The generation name %q carries no version", name))
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, parseGenerationResult{
+		Version: types.StringValue(parts.version),
+		Major:   types.Int64Value(parts.major),
+		Minor:   types.Int64Value(parts.minor),
+		Patch:   types.Int64PointerValue(parts.patch),
+	})
+}
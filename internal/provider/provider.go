@@ -6,7 +6,11 @@ import (
 	"net/url"
 
 	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,22 +20,32 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ provider.Provider = &CamundaCloudProvider{}
+var _ provider.ProviderWithListResources = &CamundaCloudProvider{}
+var _ provider.ProviderWithEphemeralResources = &CamundaCloudProvider{}
+var _ provider.ProviderWithFunctions = &CamundaCloudProvider{}
+var _ provider.ProviderWithActions = &CamundaCloudProvider{}
 
 // CamundaCloudProvider satisfies the CamundaCloudProvider.Provider interface and usually is included
 // with all Resource and DataSource implementations.
 type CamundaCloudProvider struct {
-	client      *console.APIClient
-	accessToken string
+	client          *console.APIClient
+	accessToken     string
+	credentials     *clientcredentials.Config
+	parametersCache *parametersCache
+
+	// defaultLabels are merged into the labels of every cluster.
+	defaultLabels map[string]string
 }
 
 // providerData can be used to store data from the Terraform configuration.
 type providerData struct {
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	ApiUrl       types.String `tfsdk:"api_url"`
-	TokenUrl     types.String `tfsdk:"token_url"`
-	Audience     types.String `tfsdk:"audience"`
-	Debug        types.Bool   `tfsdk:"debug"`
+	ClientID      types.String            `tfsdk:"client_id"`
+	ClientSecret  types.String            `tfsdk:"client_secret"`
+	ApiUrl        types.String            `tfsdk:"api_url"`
+	TokenUrl      types.String            `tfsdk:"token_url"`
+	Audience      types.String            `tfsdk:"audience"`
+	Debug         types.Bool              `tfsdk:"debug"`
+	DefaultLabels map[string]types.String `tfsdk:"default_labels"`
 }
 
 func New(version string) func() provider.Provider {
@@ -75,6 +89,11 @@ func (p *CamundaCloudProvider) Schema(ctx context.Context, req provider.SchemaRe
 				Required:            false,
 				Optional:            true,
 			},
+			"default_labels": schema.MapAttribute{
+				MarkdownDescription: "Labels added to every cluster managed by the provider. The `labels` of a cluster take precedence over the default labels with the same key.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -131,6 +150,7 @@ func (p *CamundaCloudProvider) Configure(ctx context.Context, req provider.Confi
 	}
 
 	p.accessToken = token.AccessToken
+	p.credentials = &config
 
 	cfg := console.NewConfiguration()
 	cfg.Scheme = apiUrl.Scheme
@@ -138,25 +158,73 @@ func (p *CamundaCloudProvider) Configure(ctx context.Context, req provider.Confi
 	cfg.Debug = data.Debug.ValueBool()
 	client := console.NewAPIClient(cfg)
 	p.client = client
+	p.parametersCache = newParametersCache(parametersCacheTTL)
+
+	p.defaultLabels = map[string]string{}
+	for key, value := range data.DefaultLabels {
+		p.defaultLabels[key] = value.ValueString()
+	}
 
 	resp.DataSourceData = p
 	resp.ResourceData = p
+	resp.ListResourceData = p
+	resp.EphemeralResourceData = p
+	resp.ActionData = p
 }
 
 func (p *CamundaCloudProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewCamundaClusterBackupResource,
 		NewCamundaClusterClientResource,
 		NewCamundaClusterConnectorSecretResource,
 		NewCamundaClusterIPWhitelistResource,
 		NewCamundaClusterResource,
 		NewCamundaOrganizationMemberResource,
+		NewCamundaOrganizationMembersResource,
+	}
+}
+
+func (p *CamundaCloudProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewCamundaClusterClientListResource,
+		NewCamundaClusterConnectorSecretListResource,
+		NewCamundaClusterListResource,
+		NewCamundaOrganizationMemberListResource,
+	}
+}
+
+func (p *CamundaCloudProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewCamundaAccessTokenEphemeralResource,
+		NewCamundaClusterClientCredentialsEphemeralResource,
+	}
+}
+
+func (p *CamundaCloudProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewCamundaClusterBackupAction,
+		NewCamundaClusterWakeAction,
+	}
+}
+
+func (p *CamundaCloudProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewCIDRNormalizeFunction,
+		NewParseGenerationFunction,
+		NewZeebeAddressFunction,
 	}
 }
 
 func (p *CamundaCloudProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewCamundaChannelDataSource,
+		NewCamundaChannelsDataSource,
+		NewCamundaClusterBackupsDataSource,
+		NewCamundaClusterIPWhitelistDataSource,
 		NewCamundaClusterPlanTypeDataSource,
+		NewCamundaClusterPlanTypesDataSource,
+		NewCamundaOrganizationMembersDataSource,
 		NewCamundaRegionDataSource,
+		NewCamundaRegionsDataSource,
 	}
 }
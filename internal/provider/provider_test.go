@@ -1,15 +1,126 @@
 package provider
 
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
 // acceptance testing. The factory function will be invoked for every Terraform
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
-// var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-// 	"camunda": providerserver.NewProtocol6WithError(New("test")()),
-// }
-
-// func testAccPreCheck(t *testing.T) {
-// 	// You can add code here to run prior to any test case execution, for example assertions
-// 	// about the appropriate environment variables being set are common to see in a pre-check
-// 	// function.
-// }
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"camunda": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+func init() {
+	// The fake Console API turns clusters healthy after a single poll.
+	clusterStatusDelay = 0
+	clusterStatusPollInterval = 0
+	memberStatusDelay = 0
+	memberStatusPollInterval = 0
+}
+
+// testAccClusterConfig returns the configuration of a cluster created on the
+// fake Console API, looked up through the data sources.
+func testAccClusterConfig(name string) string {
+	return fmt.Sprintf(`
+data "camunda_channel" "stable" {
+  name = "Stable"
+}
+
+data "camunda_region" "belgium" {
+  name = "Belgium, Europe (europe-west1)"
+}
+
+data "camunda_cluster_plan_type" "trial" {
+  name = "Trial Cluster"
+}
+
+resource "camunda_cluster" "test" {
+  name       = %[1]q
+  channel    = data.camunda_channel.stable.id
+  generation = data.camunda_channel.stable.default_generation.id
+  region     = data.camunda_region.belgium.id
+  plan_type  = data.camunda_cluster_plan_type.trial.id
+}
+`, name)
+}
+
+// configureTestProvider configures a provider through its schema, as
+// Terraform would.
+func configureTestProvider(clientID, clientSecret, apiURL, tokenURL string) (*CamundaCloudProvider, diag.Diagnostics) {
+	ctx := context.Background()
+	p := New("test")().(*CamundaCloudProvider)
+
+	schemaResp := provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"client_id":      tftypes.NewValue(tftypes.String, clientID),
+			"client_secret":  tftypes.NewValue(tftypes.String, clientSecret),
+			"api_url":        tftypes.NewValue(tftypes.String, apiURL),
+			"token_url":      tftypes.NewValue(tftypes.String, tokenURL),
+			"audience":       tftypes.NewValue(tftypes.String, nil),
+			"debug":          tftypes.NewValue(tftypes.Bool, nil),
+			"default_labels": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		}),
+	}
+
+	resp := provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+
+	return p, resp.Diagnostics
+}
+
+// testAccCredentials returns the Camunda SaaS credentials and endpoints set in
+// the environment, if any.
+func testAccCredentials() (clientID, clientSecret, apiURL, tokenURL string, ok bool) {
+	clientID, clientSecret = os.Getenv("CAMUNDA_CLIENT_ID"), os.Getenv("CAMUNDA_CLIENT_SECRET")
+	if clientID == "" || clientSecret == "" {
+		return "", "", "", "", false
+	}
+
+	apiURL, tokenURL = os.Getenv("CAMUNDA_API_URL"), os.Getenv("CAMUNDA_TOKEN_URL")
+	if apiURL == "" {
+		apiURL = "https://api.cloud.camunda.io"
+	}
+	if tokenURL == "" {
+		tokenURL = "https://login.cloud.camunda.io/oauth/token"
+	}
+
+	return clientID, clientSecret, apiURL, tokenURL, true
+}
+
+func TestProviderConfigure(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+	tokenURL := api.server.URL + "/oauth/token"
+
+	if _, diags := configureTestProvider(fakeClientID, "wrong", api.server.URL, tokenURL); !diags.HasError() {
+		t.Errorf("expected invalid credentials to be rejected")
+	}
+
+	p, diags := configureTestProvider(fakeClientID, fakeClientSecret, api.server.URL, tokenURL)
+	if diags.HasError() {
+		t.Fatalf("unexpected configure diagnostics: %v", diags)
+	}
+
+	if p.accessToken != fakeAccessToken {
+		t.Errorf("expected the access token of the fake API, got %q", p.accessToken)
+	}
+
+	if _, err := p.parameters(context.Background()); err != nil {
+		t.Errorf("unable to call the fake API with the access token: %s", err)
+	}
+}
@@ -0,0 +1,387 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+)
+
+var recordFixtures = flag.Bool("record", false, "record the fixtures of the replay tests instead of replaying them")
+
+// redacted replaces tokens and secrets in recorded fixtures.
+const redacted = "REDACTED"
+
+// fakeFixtureSource is the source of fixtures recorded against the fake
+// Console API.
+const fakeFixtureSource = "fake"
+
+// redactedKeys are the JSON keys whose values never end up in fixtures.
+var redactedKeys = map[string]bool{
+	"access_token":        true,
+	"refresh_token":       true,
+	"id_token":            true,
+	"client_secret":       true,
+	"clientsecret":        true,
+	"secretvalue":         true,
+	"zeebe_client_secret": true,
+}
+
+type fixture struct {
+	// Source is the host of the Console API the fixture was recorded
+	// against, or fakeFixtureSource for the fake Console API.
+	Source       string        `json:"source"`
+	Interactions []interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest  `json:"request"`
+	Response recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Method string          `json:"method"`
+	Path   string          `json:"path"`
+	Body   json.RawMessage `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	StatusCode  int             `json:"status_code"`
+	ContentType string          `json:"content_type,omitempty"`
+	Body        json.RawMessage `json:"body,omitempty"`
+}
+
+// recorder is an HTTP transport for the Console API client. When recording,
+// it forwards requests to the API and saves the redacted interactions to a
+// fixture file. Otherwise it replays them in order, failing the test when the
+// provider sends a different request.
+type recorder struct {
+	t      *testing.T
+	path   string
+	source string
+
+	mu           sync.Mutex
+	transport    http.RoundTripper
+	interactions []interaction
+	next         int
+}
+
+// newReplayProvider returns a provider whose Console API calls are recorded
+// to, or replayed from, testdata/fixtures/<name>.json.
+//
+// Fixtures are recorded with `go test ./internal/provider -run TestReplay
+// -record`, against the Camunda SaaS organization of the CAMUNDA_CLIENT_ID
+// and CAMUNDA_CLIENT_SECRET credentials if set, or against the fake Console
+// API otherwise. CAMUNDA_API_URL and CAMUNDA_TOKEN_URL override the default
+// endpoints.
+func newReplayProvider(t *testing.T, name string) (*CamundaCloudProvider, *recorder) {
+	t.Helper()
+
+	rec := &recorder{
+		t:    t,
+		path: filepath.Join("testdata", "fixtures", name+".json"),
+	}
+
+	if !*recordFixtures {
+		content, err := os.ReadFile(rec.path)
+		if err != nil {
+			t.Fatalf("unable to read fixture, record it with -record: %s", err)
+		}
+
+		var f fixture
+		if err := json.Unmarshal(content, &f); err != nil {
+			t.Fatalf("unable to decode fixture %s: %s", rec.path, err)
+		}
+		rec.interactions = f.Interactions
+
+		t.Cleanup(func() {
+			if rec.next != len(rec.interactions) && !t.Failed() {
+				t.Errorf("only %d out of %d recorded interactions were replayed", rec.next, len(rec.interactions))
+			}
+		})
+
+		cfg := console.NewConfiguration()
+		cfg.HTTPClient = &http.Client{Transport: rec}
+
+		return &CamundaCloudProvider{
+			client:          console.NewAPIClient(cfg),
+			accessToken:     redacted,
+			parametersCache: newParametersCache(parametersCacheTTL),
+		}, rec
+	}
+
+	clientID, clientSecret, apiURL, tokenURL, ok := testAccCredentials()
+	if !ok {
+		api := newFakeConsoleAPI(t)
+		clientID, clientSecret = fakeClientID, fakeClientSecret
+		apiURL, tokenURL = api.server.URL, api.server.URL+"/oauth/token"
+	}
+
+	p, diags := configureTestProvider(clientID, clientSecret, apiURL, tokenURL)
+	if diags.HasError() {
+		t.Fatalf("unable to configure the provider: %v", diags)
+	}
+
+	rec.source = fakeFixtureSource
+	if ok {
+		rec.source = p.client.GetConfig().Host
+	}
+
+	// Wrap the transport of the Console API client, so that requests are
+	// recorded as the provider sends them.
+	httpClient := p.client.GetConfig().HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	rec.transport = httpClient.Transport
+	if rec.transport == nil {
+		rec.transport = http.DefaultTransport
+	}
+	p.client.GetConfig().HTTPClient = &http.Client{Transport: rec, Timeout: httpClient.Timeout}
+
+	t.Cleanup(func() {
+		if t.Failed() {
+			return
+		}
+
+		content, err := json.MarshalIndent(fixture{Source: rec.source, Interactions: rec.interactions}, "", "  ")
+		if err == nil {
+			err = os.MkdirAll(filepath.Dir(rec.path), 0o755)
+		}
+		if err == nil {
+			err = os.WriteFile(rec.path, append(content, '\n'), 0o644)
+		}
+		if err != nil {
+			t.Errorf("unable to write fixture %s: %s", rec.path, err)
+		}
+	})
+
+	return p, rec
+}
+
+// secret returns the value a test should expect for a secret sent to the API:
+// replayed fixtures only carry redacted secrets.
+func (rec *recorder) secret(value string) string {
+	if rec.transport == nil {
+		return redacted
+	}
+	return value
+}
+
+func (rec *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	request := recordedRequest{
+		Method: req.Method,
+		Path:   req.URL.RequestURI(),
+		Body:   redactBody(req.URL.Path, body, false),
+	}
+
+	if rec.transport != nil {
+		return rec.record(req, request)
+	}
+
+	return rec.replay(req, request)
+}
+
+func (rec *recorder) record(req *http.Request, request recordedRequest) (*http.Response, error) {
+	resp, err := rec.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	response := recordedResponse{
+		StatusCode:  resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+		Body:        redactBody(req.URL.Path, body, true),
+	}
+	rec.interactions = append(rec.interactions, interaction{Request: request, Response: response})
+
+	return resp, nil
+}
+
+func (rec *recorder) replay(req *http.Request, request recordedRequest) (*http.Response, error) {
+	if rec.next >= len(rec.interactions) {
+		rec.t.Errorf("unexpected request %s %s, all recorded interactions were replayed", request.Method, request.Path)
+		return nil, fmt.Errorf("no recorded interaction left for %s %s", request.Method, request.Path)
+	}
+
+	recorded := rec.interactions[rec.next]
+	rec.next++
+
+	if recorded.Request.Method != request.Method || recorded.Request.Path != request.Path ||
+		!bytes.Equal(compactJSON(recorded.Request.Body), request.Body) {
+		rec.t.Errorf("request %d does not match the fixture:\n got: %s %s %s\nwant: %s %s %s", rec.next,
+			request.Method, request.Path, request.Body,
+			recorded.Request.Method, recorded.Request.Path, recorded.Request.Body)
+		return nil, fmt.Errorf("unexpected request %s %s", request.Method, request.Path)
+	}
+
+	body := []byte(recorded.Response.Body)
+	if len(body) > 0 && !strings.Contains(recorded.Response.ContentType, "json") {
+		// Non JSON bodies are stored as JSON strings.
+		var text string
+		if err := json.Unmarshal(body, &text); err == nil {
+			body = []byte(text)
+		}
+	}
+
+	header := http.Header{}
+	if recorded.Response.ContentType != "" {
+		header.Set("Content-Type", recorded.Response.ContentType)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Response.StatusCode, http.StatusText(recorded.Response.StatusCode)),
+		StatusCode:    recorded.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// redactBody returns body as JSON, with its secrets redacted. Bodies that are
+// not JSON are kept as JSON strings.
+func redactBody(path string, body []byte, response bool) json.RawMessage {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		text, _ := json.Marshal(string(body))
+		return text
+	}
+
+	// Connector secrets are listed as a map of their names to their values.
+	if response && strings.HasSuffix(path, "/secrets") {
+		if secrets, ok := value.(map[string]interface{}); ok {
+			for name := range secrets {
+				secrets[name] = redacted
+			}
+		}
+	}
+
+	out, _ := json.Marshal(redactValue(value))
+	return out
+}
+
+// compactJSON strips the indentation of fixture bodies before comparing them.
+func compactJSON(body json.RawMessage) []byte {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, body); err != nil {
+		return body
+	}
+	return buf.Bytes()
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if redactedKeys[strings.ToLower(key)] {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+
+	return value
+}
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     string
+		body     string
+		response bool
+		expect   string
+	}{
+		"token": {
+			path:   "/oauth/token",
+			body:   `{"access_token":"eyJ","token_type":"Bearer"}`,
+			expect: `{"access_token":"REDACTED","token_type":"Bearer"}`,
+		},
+		"created client": {
+			path:   "/clusters/1/clients",
+			body:   `{"clientId":"abc","clientSecret":"s3cr3t"}`,
+			expect: `{"clientId":"abc","clientSecret":"REDACTED"}`,
+		},
+		"created secret": {
+			path:   "/clusters/1/secrets",
+			body:   `{"secretName":"KEY","secretValue":"s3cr3t"}`,
+			expect: `{"secretName":"KEY","secretValue":"REDACTED"}`,
+		},
+		"listed secrets": {
+			path:     "/clusters/1/secrets",
+			body:     `{"KEY":"s3cr3t"}`,
+			response: true,
+			expect:   `{"KEY":"REDACTED"}`,
+		},
+		"nested": {
+			path:   "/clusters",
+			body:   `[{"name":"test","credentials":{"client_secret":"s3cr3t"}}]`,
+			expect: `[{"credentials":{"client_secret":"REDACTED"},"name":"test"}]`,
+		},
+		"text": {
+			path:   "/clusters/1",
+			body:   "cluster not found\n",
+			expect: `"cluster not found\n"`,
+		},
+		"empty": {
+			path:   "/clusters/1",
+			body:   "",
+			expect: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := string(redactBody(testCase.path, []byte(testCase.body), testCase.response)); got != testCase.expect {
+				t.Errorf("expected %s, got %s", testCase.expect, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// replayClusterPlan plans a trial cluster on the default channel.
+func replayClusterPlan(t *testing.T, p *CamundaCloudProvider, name string) camundaClusterData {
+	t.Helper()
+
+	params, err := p.parameters(context.Background())
+	if err != nil {
+		t.Fatalf("unable to read parameters: %s", formatClientError(err))
+	}
+
+	plan := camundaClusterData{
+		Id:                    types.StringUnknown(),
+		Name:                  types.StringValue(name),
+		Stage:                 types.StringUnknown(),
+		Labels:                types.MapNull(types.StringType),
+		AllLabels:             types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		DeletionProtection:    types.BoolValue(false),
+		WaitForHealthy:        types.BoolValue(true),
+		WaitForComponents:     types.SetValueMust(types.StringType, []attr.Value{}),
+		DeleteOnCreateFailure: types.BoolValue(false),
+	}
+
+	for _, channel := range params.Channels {
+		if channel.IsDefault {
+			plan.Channel = types.StringValue(channel.Uuid)
+			plan.Generation = types.StringValue(channel.DefaultGeneration.Uuid)
+		}
+	}
+
+	for _, planType := range params.ClusterPlanTypes {
+		if strings.Contains(planType.Name, "Trial") {
+			plan.PlanType = types.StringValue(planType.Uuid)
+			plan.Region = types.StringValue(planType.Region.Uuid)
+			break
+		}
+	}
+
+	if plan.Channel.IsNull() || plan.PlanType.IsNull() {
+		t.Fatalf("no default channel or trial cluster plan type available")
+	}
+
+	return plan
+}
+
+// replayCluster creates a cluster, deleted at the end of the test.
+func replayCluster(t *testing.T, p *CamundaCloudProvider) string {
+	t.Helper()
+
+	r := &CamundaClusterResource{provider: p}
+
+	state, diags := testCreate(t, r, replayClusterPlan(t, p, "tf-acc-replay"))
+	if diags.HasError() {
+		t.Fatalf("unable to create cluster: %v", diags)
+	}
+
+	var data camundaClusterData
+	state.Get(context.Background(), &data)
+
+	t.Cleanup(func() {
+		if diags := testDelete(t, r, state); diags.HasError() {
+			t.Errorf("unable to delete cluster: %v", diags)
+		}
+	})
+
+	return data.Id.ValueString()
+}
+
+func TestReplayCamundaClusterResource(t *testing.T) {
+	ctx := context.Background()
+	p, _ := newReplayProvider(t, "cluster")
+	r := &CamundaClusterResource{provider: p}
+
+	plan := replayClusterPlan(t, p, "tf-acc-replay")
+
+	invalid := plan
+	invalid.Generation = types.StringValue("unknown-generation")
+	if _, diags := testCreate(t, r, invalid); !diags.HasError() {
+		t.Errorf("expected the creation of a cluster with an unknown generation to fail")
+	}
+
+	state, diags := testCreate(t, r, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	var data camundaClusterData
+	state.Get(ctx, &data)
+	if data.Id.ValueString() == "" || data.Name.ValueString() != "tf-acc-replay" || !data.Generation.Equal(plan.Generation) {
+		t.Errorf("unexpected cluster state: %+v", data)
+	}
+
+	if diags := testDelete(t, r, state); diags.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", diags)
+	}
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if !state.Raw.IsNull() {
+		t.Errorf("expected a deleted cluster to be removed from the state")
+	}
+}
+
+func TestReplayCamundaClusterClientResource(t *testing.T) {
+	ctx := context.Background()
+	p, _ := newReplayProvider(t, "cluster_client")
+	clusterID := replayCluster(t, p)
+	r := &CamundaClusterClientResource{provider: p}
+
+	state, diags := testCreate(t, r, camundaClusterClientData{
+		Id:                          types.StringUnknown(),
+		ClusterId:                   types.StringValue(clusterID),
+		Name:                        types.StringValue("tf-acc-replay"),
+		Secret:                      types.StringUnknown(),
+		Scopes:                      []types.String{types.StringValue("Zeebe")},
+		ZeebeAddress:                types.StringUnknown(),
+		ZeebeClientId:               types.StringUnknown(),
+		ZeebeAuthorizationServerUrl: types.StringUnknown(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	var data camundaClusterClientData
+	state.Get(ctx, &data)
+	if data.ZeebeClientId.ValueString() == "" || data.Secret.ValueString() == "" || data.ZeebeAddress.ValueString() == "" {
+		t.Errorf("unexpected cluster client state: %+v", data)
+	}
+
+	if diags := testDelete(t, r, state); diags.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", diags)
+	}
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if !state.Raw.IsNull() {
+		t.Errorf("expected a deleted cluster client to be removed from the state")
+	}
+}
+
+func TestReplayCamundaClusterConnectorSecretResource(t *testing.T) {
+	ctx := context.Background()
+	p, rec := newReplayProvider(t, "cluster_connector_secret")
+	clusterID := replayCluster(t, p)
+	r := &CamundaClusterConnectorSecretResource{provider: p}
+
+	state, diags := testCreate(t, r, camundaClusterConnectorSecret{
+		ClusterId: types.StringValue(clusterID),
+		Name:      types.StringValue("TF_ACC_REPLAY"),
+		Value:     types.StringValue("s3cr3t"),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	var data camundaClusterConnectorSecret
+	state.Get(ctx, &data)
+	if data.Value.ValueString() != rec.secret("s3cr3t") {
+		t.Errorf("unexpected connector secret value %q", data.Value.ValueString())
+	}
+
+	if diags := testDelete(t, r, state); diags.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", diags)
+	}
+
+	if diags := testDelete(t, r, state); !diags.HasError() {
+		t.Errorf("expected deleting a missing connector secret to fail")
+	}
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if !state.Raw.IsNull() {
+		t.Errorf("expected a deleted connector secret to be removed from the state")
+	}
+}
+
+func TestReplayCamundaClusterIPWhitelistResource(t *testing.T) {
+	ctx := context.Background()
+	p, _ := newReplayProvider(t, "cluster_ip_whitelist")
+	clusterID := replayCluster(t, p)
+	r := &CamundaClusterIPWhiteListResource{provider: p}
+
+	state, diags := testCreate(t, r, camundaClusterIPWhitelistData{
+		Id:        types.StringUnknown(),
+		ClusterID: types.StringValue(clusterID),
+		IPWhitelist: []ipWhitelistModel{
+			{IP: types.StringValue("10.0.0.0/8"), Description: types.StringValue("office")},
+		},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	var data camundaClusterIPWhitelistData
+	state.Get(ctx, &data)
+	if len(data.IPWhitelist) != 1 || data.IPWhitelist[0].IP.ValueString() != "10.0.0.0/8" {
+		t.Errorf("unexpected IP whitelist state: %+v", data.IPWhitelist)
+	}
+
+	if diags := testDelete(t, r, state); diags.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", diags)
+	}
+
+	missing := stateFrom(t, testResourceSchema(t, r), camundaClusterIPWhitelistData{
+		Id:        types.StringValue("00000000-0000-0000-0000-000000000000"),
+		ClusterID: types.StringValue("00000000-0000-0000-0000-000000000000"),
+	})
+
+	state, diags = testRead(t, r, missing)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if !state.Raw.IsNull() {
+		t.Errorf("expected the IP whitelist of a missing cluster to be removed from the state")
+	}
+}
+
+func TestReplayCamundaOrganizationMemberResource(t *testing.T) {
+	ctx := context.Background()
+	p, _ := newReplayProvider(t, "organization_member")
+	r := &CamundaOrganizationMemberResource{provider: p}
+
+	member := func(roles ...string) camundaOrganizationMemberData {
+		roleSet, _ := types.SetValueFrom(ctx, types.StringType, roles)
+
+		return camundaOrganizationMemberData{
+			Email:                 types.StringValue("tf-acc-replay@example.org"),
+			Roles:                 roleSet,
+			Status:                types.StringUnknown(),
+			WaitForAcceptance:     types.BoolValue(false),
+			AcceptanceTimeout:     types.StringValue("30m"),
+			AllowLastAdminRemoval: types.BoolValue(false),
+		}
+	}
+
+	state, diags := testCreate(t, r, member("developer"))
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	updated := member("analyst", "developer")
+	updated.Status = types.StringValue(memberStatusActive)
+
+	state, diags = testUpdate(t, r, state, updated)
+	if diags.HasError() {
+		t.Fatalf("unexpected update diagnostics: %v", diags)
+	}
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	var data camundaOrganizationMemberData
+	state.Get(ctx, &data)
+	if !setHasRole(data.Roles, "analyst") || !setHasRole(data.Roles, "developer") {
+		t.Errorf("unexpected member roles: %s", data.Roles)
+	}
+
+	if diags := testDelete(t, r, state); diags.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", diags)
+	}
+}
+
+func TestReplayCamundaClusterBackupResource(t *testing.T) {
+	ctx := context.Background()
+	p, _ := newReplayProvider(t, "cluster_backup")
+	clusterID := replayCluster(t, p)
+	r := &CamundaClusterBackupResource{provider: p}
+
+	state, diags := testCreate(t, r, camundaClusterBackupData{
+		Id:         types.StringUnknown(),
+		ClusterId:  types.StringValue(clusterID),
+		State:      types.StringUnknown(),
+		BackupTime: types.StringUnknown(),
+		Timeout:    types.StringValue(defaultActionTimeout),
+
+		DeleteOnCreateFailure: types.BoolValue(false),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", diags)
+	}
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+
+	var data camundaClusterBackupData
+	state.Get(ctx, &data)
+	if data.State.ValueString() != backupStateCompleted {
+		t.Errorf("expected the backup to be completed, got %q", data.State.ValueString())
+	}
+
+	if diags := testDelete(t, r, state); diags.HasError() {
+		t.Fatalf("unexpected delete diagnostics: %v", diags)
+	}
+
+	state, diags = testRead(t, r, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected read diagnostics: %v", diags)
+	}
+	if !state.Raw.IsNull() {
+		t.Errorf("expected a deleted backup to be removed from the state")
+	}
+}
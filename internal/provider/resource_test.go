@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testResourceSchema(t *testing.T, r resource.Resource) schema.Schema {
+	t.Helper()
+
+	resp := resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema diagnostics: %v", resp.Diagnostics)
+	}
+
+	return resp.Schema
+}
+
+// testResourceIdentity returns a null identity of r, as Terraform sends it
+// before the resource is created or imported.
+func testResourceIdentity(t *testing.T, r resource.Resource) *tfsdk.ResourceIdentity {
+	t.Helper()
+
+	withIdentity, ok := r.(resource.ResourceWithIdentity)
+	if !ok {
+		return nil
+	}
+
+	resp := resource.IdentitySchemaResponse{}
+	withIdentity.IdentitySchema(context.Background(), resource.IdentitySchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected identity schema diagnostics: %v", resp.Diagnostics)
+	}
+
+	return &tfsdk.ResourceIdentity{
+		Schema: resp.IdentitySchema,
+		Raw:    tftypes.NewValue(resp.IdentitySchema.Type().TerraformType(context.Background()), nil),
+	}
+}
+
+// initPrivate initializes the private state data of a response, as the
+// framework does. Its type is internal to the framework.
+func initPrivate(resp interface{}) {
+	field := reflect.ValueOf(resp).Elem().FieldByName("Private")
+	field.Set(reflect.New(field.Type().Elem()))
+}
+
+func testCreate(t *testing.T, r resource.Resource, plan interface{}) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	s := testResourceSchema(t, r)
+	resp := resource.CreateResponse{State: emptyState(s), Identity: testResourceIdentity(t, r)}
+	initPrivate(&resp)
+	r.Create(context.Background(), resource.CreateRequest{Plan: planFrom(t, s, plan)}, &resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+func testRead(t *testing.T, r resource.Resource, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	resp := resource.ReadResponse{State: state, Identity: testResourceIdentity(t, r)}
+	initPrivate(&resp)
+	r.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+func testUpdate(t *testing.T, r resource.Resource, state tfsdk.State, plan interface{}) (tfsdk.State, diag.Diagnostics) {
+	t.Helper()
+
+	s := testResourceSchema(t, r)
+	resp := resource.UpdateResponse{State: stateFrom(t, s, plan), Identity: testResourceIdentity(t, r)}
+	initPrivate(&resp)
+	r.Update(context.Background(), resource.UpdateRequest{State: state, Plan: planFrom(t, s, plan)}, &resp)
+
+	return resp.State, resp.Diagnostics
+}
+
+func testDelete(t *testing.T, r resource.Resource, state tfsdk.State) diag.Diagnostics {
+	t.Helper()
+
+	resp := resource.DeleteResponse{State: state}
+	r.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+
+	return resp.Diagnostics
+}
+
+func testModifyPlan(t *testing.T, r resource.ResourceWithModifyPlan, state tfsdk.State, plan interface{}) diag.Diagnostics {
+	t.Helper()
+
+	s := testResourceSchema(t, r)
+	p := planFrom(t, s, plan)
+	resp := resource.ModifyPlanResponse{Plan: p}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{State: state, Plan: p, Config: tfsdk.Config{Schema: s, Raw: p.Raw}}, &resp)
+
+	return resp.Diagnostics
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	console "github.com/camunda-community-hub/console-customer-api-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// sweepPrefix is the prefix of the names of everything created by the
+// acceptance tests. Connector secret names use underscores, such as
+// `TF_ACC_SECRET`.
+const sweepPrefix = "tf-acc-"
+
+// sweeperProvider returns the provider the sweepers clean up with.
+var sweeperProvider = func() (*CamundaCloudProvider, error) {
+	clientID, clientSecret, apiURL, tokenURL, ok := testAccCredentials()
+	if !ok {
+		return nil, fmt.Errorf("CAMUNDA_CLIENT_ID and CAMUNDA_CLIENT_SECRET must be set to run sweepers")
+	}
+
+	p, diags := configureTestProvider(clientID, clientSecret, apiURL, tokenURL)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to configure the provider: %v", diags)
+	}
+
+	return p, nil
+}
+
+func init() {
+	resource.AddTestSweepers("camunda_cluster_client", &resource.Sweeper{
+		Name: "camunda_cluster_client",
+		F:    sweepClusterClients,
+	})
+
+	resource.AddTestSweepers("camunda_cluster_connector_secret", &resource.Sweeper{
+		Name: "camunda_cluster_connector_secret",
+		F:    sweepClusterConnectorSecrets,
+	})
+
+	resource.AddTestSweepers("camunda_cluster", &resource.Sweeper{
+		Name:         "camunda_cluster",
+		Dependencies: []string{"camunda_cluster_client", "camunda_cluster_connector_secret"},
+		F:            sweepClusters,
+	})
+
+	resource.AddTestSweepers("camunda_organization_member", &resource.Sweeper{
+		Name: "camunda_organization_member",
+		F:    sweepOrganizationMembers,
+	})
+}
+
+// TestMain runs the sweepers with `go test ./internal/provider -sweep=all`.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func isSweepable(name string) bool {
+	return strings.HasPrefix(strings.ReplaceAll(strings.ToLower(name), "_", "-"), sweepPrefix)
+}
+
+func sweeperClusters(ctx context.Context) (*CamundaCloudProvider, context.Context, []console.Cluster, error) {
+	p, err := sweeperProvider()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	ctx = context.WithValue(ctx, console.ContextAccessToken, p.accessToken)
+	clusters, _, err := p.client.DefaultAPI.GetClusters(ctx).Execute()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to list clusters: %s", formatClientError(err))
+	}
+
+	return p, ctx, clusters, nil
+}
+
+func sweepClusters(_ string) error {
+	p, ctx, clusters, err := sweeperClusters(context.Background())
+	if err != nil {
+		return err
+	}
+
+	for _, cluster := range clusters {
+		if !isSweepable(cluster.Name) {
+			continue
+		}
+
+		if _, err := p.client.DefaultAPI.DeleteCluster(ctx, cluster.Uuid).Execute(); err != nil {
+			return fmt.Errorf("unable to delete cluster %s: %s", cluster.Uuid, formatClientError(err))
+		}
+	}
+
+	return nil
+}
+
+func sweepClusterClients(_ string) error {
+	p, ctx, clusters, err := sweeperClusters(context.Background())
+	if err != nil {
+		return err
+	}
+
+	for _, cluster := range clusters {
+		clients, _, err := p.client.DefaultAPI.GetClients(ctx, cluster.Uuid).Execute()
+		if err != nil {
+			return fmt.Errorf("unable to list the clients of cluster %s: %s", cluster.Uuid, formatClientError(err))
+		}
+
+		for _, client := range clients {
+			if !isSweepable(client.Name) {
+				continue
+			}
+
+			if _, err := p.client.DefaultAPI.DeleteClient(ctx, cluster.Uuid, client.ClientId).Execute(); err != nil {
+				return fmt.Errorf("unable to delete client %s of cluster %s: %s", client.ClientId, cluster.Uuid, formatClientError(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+func sweepClusterConnectorSecrets(_ string) error {
+	p, ctx, clusters, err := sweeperClusters(context.Background())
+	if err != nil {
+		return err
+	}
+
+	for _, cluster := range clusters {
+		secrets, _, err := p.client.DefaultAPI.GetSecrets(ctx, cluster.Uuid).Execute()
+		if err != nil {
+			return fmt.Errorf("unable to list the connector secrets of cluster %s: %s", cluster.Uuid, formatClientError(err))
+		}
+
+		for name := range secrets {
+			if !isSweepable(name) {
+				continue
+			}
+
+			if _, err := p.client.DefaultAPI.DeleteSecret(ctx, cluster.Uuid, name).Execute(); err != nil {
+				return fmt.Errorf("unable to delete connector secret %s of cluster %s: %s", name, cluster.Uuid, formatClientError(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+func sweepOrganizationMembers(_ string) error {
+	p, err := sweeperProvider()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.WithValue(context.Background(), console.ContextAccessToken, p.accessToken)
+	members, _, err := p.client.DefaultAPI.GetMembers(ctx).Execute()
+	if err != nil {
+		return fmt.Errorf("unable to list organization members: %s", formatClientError(err))
+	}
+
+	for _, member := range members {
+		if !isSweepable(member.Email) || memberHasRole(member, organizationRoleOwner) {
+			continue
+		}
+
+		if _, err := p.client.DefaultAPI.DeleteMember(ctx, member.Email).Execute(); err != nil {
+			return fmt.Errorf("unable to delete organization member %s: %s", member.Email, formatClientError(err))
+		}
+	}
+
+	return nil
+}
+
+func TestSweepers(t *testing.T) {
+	api := newFakeConsoleAPI(t)
+
+	api.addCluster("tf-acc-leaked")
+	api.addCluster("production")
+	api.addClient("production", "tf-acc-client")
+	api.addClient("production", "worker")
+	api.addSecret("production", "TF_ACC_SECRET", "leaked")
+	api.addSecret("production", "API_KEY", "kept")
+	api.addMember("tf-acc-member@example.org", "developer")
+	api.addMember("jane@example.org", "admin")
+
+	provider := sweeperProvider
+	sweeperProvider = func() (*CamundaCloudProvider, error) { return api.provider(), nil }
+	t.Cleanup(func() { sweeperProvider = provider })
+
+	for _, sweep := range []resource.SweeperFunc{sweepClusterClients, sweepClusterConnectorSecrets, sweepClusters, sweepOrganizationMembers} {
+		if err := sweep("fake"); err != nil {
+			t.Fatalf("unexpected sweeper error: %s", err)
+		}
+	}
+
+	if names := api.clusterNames(); !reflect.DeepEqual(names, []string{"production"}) {
+		t.Errorf("expected only the production cluster to be kept, got %v", names)
+	}
+
+	if names := api.clientNames("production"); !reflect.DeepEqual(names, []string{"worker"}) {
+		t.Errorf("expected only the worker client to be kept, got %v", names)
+	}
+
+	if names := api.secretNames("production"); !reflect.DeepEqual(names, []string{"API_KEY"}) {
+		t.Errorf("expected only the API_KEY secret to be kept, got %v", names)
+	}
+
+	if emails := api.memberEmails(); !reflect.DeepEqual(emails, []string{"jane@example.org", fakeOwnerEmail}) {
+		t.Errorf("expected test members to be removed, got %v", emails)
+	}
+}
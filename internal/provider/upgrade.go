@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// stateUpgrader returns the upgrader of states written with priorSchema: they
+// are decoded into a value of type T, converted by upgrade, and stored with
+// the current schema of the resource.
+//
+// Prior schemas and their models are frozen copies of the schemas of older
+// versions: they must not change when the current schema does.
+func stateUpgrader[T any, U any](priorSchema schema.Schema, upgrade func(T) U) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		PriorSchema: &priorSchema,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			var prior T
+
+			diags := req.State.Get(ctx, &prior)
+			resp.Diagnostics.Append(diags...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			upgraded := upgrade(prior)
+
+			diags = resp.State.Set(ctx, &upgraded)
+			resp.Diagnostics.Append(diags...)
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// TestUpgradeResourceState upgrades states as written by the provider before
+// schemas were versioned.
+func TestUpgradeResourceState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typeName string
+		state    string
+		expect   map[string]tftypes.Value
+	}{
+		"cluster": {
+			typeName: "camunda_cluster",
+			state:    `{"id":"cluster-1","name":"test","channel":"Stable","region":"europe-west1","plan_type":"Trial","generation":"8.6"}`,
+			expect: map[string]tftypes.Value{
+				"id":                       tftypes.NewValue(tftypes.String, "cluster-1"),
+				"generation":               tftypes.NewValue(tftypes.String, "8.6"),
+				"deletion_protection":      tftypes.NewValue(tftypes.Bool, false),
+				"wait_for_healthy":         tftypes.NewValue(tftypes.Bool, true),
+				"delete_on_create_failure": tftypes.NewValue(tftypes.Bool, false),
+			},
+		},
+		"cluster client": {
+			typeName: "camunda_cluster_client",
+			state:    `{"id":"client-1","cluster_id":"cluster-1","name":"worker","scopes":["Zeebe"],"secret":"s3cr3t","zeebe_address":"cluster-1.bru-2.zeebe.camunda.io:443","zeebe_client_id":"client-1","zeebe_authorization_server_url":"https://login.cloud.camunda.io/oauth/token"}`,
+			expect: map[string]tftypes.Value{
+				"secret": tftypes.NewValue(tftypes.String, "s3cr3t"),
+				"scopes": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "Zeebe")}),
+			},
+		},
+		"connector secret": {
+			typeName: "camunda_cluster_connector_secret",
+			state:    `{"cluster_id":"cluster-1","name":"API_KEY","value":"s3cr3t"}`,
+			expect: map[string]tftypes.Value{
+				"value": tftypes.NewValue(tftypes.String, "s3cr3t"),
+			},
+		},
+		"imported ip whitelist": {
+			typeName: "camunda_cluster_ip_whitelist",
+			state:    `{"id":"cluster-1","cluster_id":null,"ip_whitelist":[{"ip":"10.0.0.0/8","description":"office"}]}`,
+			expect: map[string]tftypes.Value{
+				"cluster_id": tftypes.NewValue(tftypes.String, "cluster-1"),
+			},
+		},
+		"organization member": {
+			typeName: "camunda_organization_member",
+			state:    `{"email":"jane@example.org","roles":["developer"]}`,
+			expect: map[string]tftypes.Value{
+				"email":                    tftypes.NewValue(tftypes.String, "jane@example.org"),
+				"status":                   tftypes.NewValue(tftypes.String, nil),
+				"wait_for_acceptance":      tftypes.NewValue(tftypes.Bool, false),
+				"acceptance_timeout":       tftypes.NewValue(tftypes.String, "30m"),
+				"allow_last_admin_removal": tftypes.NewValue(tftypes.Bool, false),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			server := providerserver.NewProtocol6(New("test")())()
+
+			schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+			if err != nil {
+				t.Fatalf("unable to get the provider schema: %s", err)
+			}
+			s := schemaResp.ResourceSchemas[testCase.typeName]
+
+			resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: testCase.typeName,
+				Version:  0,
+				RawState: &tfprotov6.RawState{JSON: []byte(testCase.state)},
+			})
+			if err != nil {
+				t.Fatalf("unable to upgrade the state: %s", err)
+			}
+			for _, d := range resp.Diagnostics {
+				if d.Severity == tfprotov6.DiagnosticSeverityError {
+					t.Fatalf("unexpected upgrade diagnostics: %s: %s", d.Summary, d.Detail)
+				}
+			}
+
+			value, err := resp.UpgradedState.Unmarshal(s.ValueType())
+			if err != nil {
+				t.Fatalf("unable to decode the upgraded state: %s", err)
+			}
+
+			var attributes map[string]tftypes.Value
+			if err := value.As(&attributes); err != nil {
+				t.Fatalf("unable to decode the upgraded state: %s", err)
+			}
+
+			for attribute, expect := range testCase.expect {
+				if !attributes[attribute].Equal(expect) {
+					t.Errorf("expected %s to be %s, got %s", attribute, expect, attributes[attribute])
+				}
+			}
+		})
+	}
+}
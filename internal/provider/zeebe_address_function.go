@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &ZeebeAddressFunction{}
+
+// zeebeAddress returns the gRPC address of the Zeebe gateway of a cluster.
+func zeebeAddress(clusterId string, zone string) string {
+	return fmt.Sprintf("%s.%s.zeebe.camunda.io:443", clusterId, zone)
+}
+
+type ZeebeAddressFunction struct{}
+
+func NewZeebeAddressFunction() function.Function {
+	return &ZeebeAddressFunction{}
+}
+
+func (f *ZeebeAddressFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "zeebe_address"
+}
+
+func (f *ZeebeAddressFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Build the Zeebe address of a cluster",
+		MarkdownDescription: "Builds the gRPC address of the Zeebe gateway of a cluster, such as `<cluster_id>.bru-2.zeebe.camunda.io:443`, from the ID of the cluster and the zone of its region, as returned by the `camunda_region` data source.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cluster_id",
+				MarkdownDescription: "The ID of the cluster",
+			},
+			function.StringParameter{
+				Name:                "zone",
+				MarkdownDescription: "The zone of the region of the cluster, such as `bru-2`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ZeebeAddressFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var clusterId, zone string
+
+	resp.Error = req.Arguments.Get(ctx, &clusterId, &zone)
+	if resp.Error != nil {
+		return
+	}
+
+	if clusterId == "" {
+		resp.Error = function.NewArgumentFuncError(0, "The cluster ID must not be empty")
+		return
+	}
+	if zone == "" {
+		resp.Error = function.NewArgumentFuncError(1, "The zone must not be empty")
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, zeebeAddress(clusterId, zone))
+}
@@ -0,0 +1,49 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = IsDuration{}
+
+// IsDuration checks if a string is a valid, positive duration such as `30m`.
+type IsDuration struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator IsDuration) Description(_ context.Context) string {
+	return "the string must be a valid positive duration, such as `30m` or `2h`"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator IsDuration) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v IsDuration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration Value",
+			fmt.Sprintf("%s", err),
+		)
+		return
+	}
+
+	if duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration Value",
+			fmt.Sprintf("duration must be positive, got: %s", duration),
+		)
+	}
+}
@@ -0,0 +1,60 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestValidatorDuration calls ValidateString to check the validation work as expected.
+func TestValidatorDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         string
+		expectSuccess bool
+	}{
+		"valid duration": {
+			value:         "30m",
+			expectSuccess: true,
+		},
+		"valid compound duration": {
+			value:         "1h30m",
+			expectSuccess: true,
+		},
+		"invalid": {
+			value:         "foobar",
+			expectSuccess: false,
+		},
+		"missing unit": {
+			value:         "30",
+			expectSuccess: false,
+		},
+		"negative duration": {
+			value:         "-5m",
+			expectSuccess: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			req := validator.StringRequest{
+				ConfigValue: types.StringValue(testCase.value),
+			}
+			resp := validator.StringResponse{}
+
+			v := IsDuration{}
+			v.ValidateString(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() == testCase.expectSuccess {
+				t.Errorf("Value '%s' should have validated: %v", testCase.value, testCase.expectSuccess)
+			}
+		})
+	}
+}
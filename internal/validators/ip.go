@@ -29,19 +29,32 @@ func (v IsIPNetwork) ValidateString(ctx context.Context, req validator.StringReq
 		return
 	}
 
-	value := req.ConfigValue.ValueString()
+	_, err := ParseIPNetwork(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Network Value",
+			fmt.Sprintf("%s", err),
+		)
+		return
+	}
+}
 
+// ParseIPNetwork parses an IP address or network as accepted by IsIPNetwork.
+// An IP address is returned as a network of a single address, and the host
+// bits of a network are cleared, so that `10.0.0.1` becomes `10.0.0.1/32` and
+// `10.0.0.1/24` becomes `10.0.0.0/24`.
+func ParseIPNetwork(value string) (*net.IPNet, error) {
 	ip := net.ParseIP(value)
 	if ip == nil { // Unable to parse the IP address
+		_, network, err := net.ParseCIDR(value)
+		return network, err
+	}
 
-		_, _, err := net.ParseCIDR(value)
-		if err != nil {
-			resp.Diagnostics.AddAttributeError(
-				req.Path,
-				"Invalid Network Value",
-				fmt.Sprintf("%s", err),
-			)
-			return
-		}
+	if ip4 := ip.To4(); ip4 != nil {
+		ip = ip4
 	}
+	bits := 8 * len(ip)
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
 }
@@ -54,3 +54,56 @@ func TestValidatorIPNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestParseIPNetwork(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value  string
+		expect string
+	}{
+		"ipv4 address": {
+			value:  "127.0.0.1",
+			expect: "127.0.0.1/32",
+		},
+		"ipv4 network": {
+			value:  "192.168.0.0/24",
+			expect: "192.168.0.0/24",
+		},
+		"ipv4 network with host bits": {
+			value:  "192.168.0.12/24",
+			expect: "192.168.0.0/24",
+		},
+		"ipv6 address": {
+			value:  "2001:db8::1",
+			expect: "2001:db8::1/128",
+		},
+		"ipv6 network with host bits": {
+			value:  "2001:db8::1/32",
+			expect: "2001:db8::/32",
+		},
+		"invalid": {
+			value: "foobar",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			network, err := ParseIPNetwork(testCase.value)
+			if testCase.expect == "" {
+				if err == nil {
+					t.Errorf("Value '%s' should not have parsed, got %s", testCase.value, network)
+				}
+				return
+			}
+
+			if err != nil || network.String() != testCase.expect {
+				t.Errorf("Value '%s' should have parsed to %s, got %v (%v)", testCase.value, testCase.expect, network, err)
+			}
+		})
+	}
+}
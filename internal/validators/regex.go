@@ -0,0 +1,40 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = IsRegex{}
+
+// IsRegex checks if a string is a valid regular expression.
+type IsRegex struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator IsRegex) Description(_ context.Context) string {
+	return "the string must be a valid regular expression"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator IsRegex) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v IsRegex) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := regexp.Compile(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("%s", err),
+		)
+	}
+}
@@ -0,0 +1,56 @@
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestValidatorRegex calls ValidateString to check the validation work as expected.
+func TestValidatorRegex(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         string
+		expectSuccess bool
+	}{
+		"literal": {
+			value:         "Stable",
+			expectSuccess: true,
+		},
+		"pattern": {
+			value:         "^europe-.*[0-9]$",
+			expectSuccess: true,
+		},
+		"unbalanced parenthesis": {
+			value:         "(europe",
+			expectSuccess: false,
+		},
+		"invalid repetition": {
+			value:         "*west",
+			expectSuccess: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			req := validator.StringRequest{
+				ConfigValue: types.StringValue(testCase.value),
+			}
+			resp := validator.StringResponse{}
+
+			v := IsRegex{}
+			v.ValidateString(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() == testCase.expectSuccess {
+				t.Errorf("Value '%s' should have validated: %v", testCase.value, testCase.expectSuccess)
+			}
+		})
+	}
+}